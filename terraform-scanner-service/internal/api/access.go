@@ -0,0 +1,99 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errOutsideRoots는 target이 허용된 스캔 루트 밖에 있을 때 반환됩니다
+var errOutsideRoots = errors.New("target path is outside the allowed scan roots")
+
+// resolveTarget은 요청의 target을 실제 경로로 변환하고 허용된 스캔 루트 안에 있는지 확인합니다
+// ".."와 심볼릭 링크를 모두 해석한 경로로 비교하며, 거부된 요청은 로그로 남깁니다
+func (h *Handler) resolveTarget(c *gin.Context, target string) (string, int, error) {
+	abs, err := filepath.Abs(target)
+	if err != nil {
+		return "", http.StatusBadRequest, fmt.Errorf("invalid target path: %w", err)
+	}
+
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		// 존재하지 않는 경로도 루트 밖 (루트 안의 링크를 거쳐 밖을 가리키는 경우 포함) 이면 존재 여부를 알려주지 않음
+		if path := resolveMissing(abs); !h.withinRoots(path) {
+			h.denyTarget(c, target, path)
+			return "", http.StatusForbidden, errOutsideRoots
+		}
+		if os.IsNotExist(err) {
+			return "", http.StatusBadRequest, fmt.Errorf("target path not found: %s", target)
+		}
+		return "", http.StatusBadRequest, fmt.Errorf("invalid target path: %w", err)
+	}
+
+	if !h.withinRoots(resolved) {
+		h.denyTarget(c, target, resolved)
+		return "", http.StatusForbidden, errOutsideRoots
+	}
+
+	return resolved, http.StatusOK, nil
+}
+
+// maxLinks는 존재하지 않는 경로를 해석할 때 따라가는 심볼릭 링크의 최대 수입니다 (순환 링크 방지)
+const maxLinks = 255
+
+// resolveMissing은 존재하지 않는 경로에서 존재하는 가장 가까운 상위 경로의 심볼릭 링크를 해석하고
+// 나머지 경로를 붙여 반환합니다 (대상이 없는 링크는 가리키는 경로로 다시 해석)
+func resolveMissing(path string) string {
+	rest := ""
+	for links := 0; ; {
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			return filepath.Join(resolved, rest)
+		}
+
+		if link, err := os.Readlink(path); err == nil && links < maxLinks {
+			links++
+			if !filepath.IsAbs(link) {
+				link = filepath.Join(filepath.Dir(path), link)
+			}
+			path = filepath.Clean(link)
+			continue
+		}
+
+		parent := filepath.Dir(path)
+		if parent == path {
+			return filepath.Join(path, rest)
+		}
+		rest = filepath.Join(filepath.Base(path), rest)
+		path = parent
+	}
+}
+
+// withinRoots는 경로가 스캔 루트 중 하나와 같거나 그 하위에 있는지 확인합니다
+// 루트도 심볼릭 링크를 해석한 실제 경로로 비교합니다
+func (h *Handler) withinRoots(path string) bool {
+	for _, root := range h.config.Server.ScanRoots {
+		rootPath, err := filepath.Abs(root)
+		if err != nil {
+			continue
+		}
+		if resolved, err := filepath.EvalSymlinks(rootPath); err == nil {
+			rootPath = resolved
+		}
+
+		if path == rootPath || strings.HasPrefix(path, rootPath+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// denyTarget은 거부된 스캔 대상 요청을 기록합니다
+func (h *Handler) denyTarget(c *gin.Context, target, resolved string) {
+	log.Printf("Denied scan target outside allowed roots: target=%q resolved=%q client=%s\n", target, resolved, c.ClientIP())
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"terraform-scanner-service/internal/config"
+)
+
+func TestResolveTarget(t *testing.T) {
+	base := t.TempDir()
+	if resolved, err := filepath.EvalSymlinks(base); err == nil {
+		base = resolved
+	}
+
+	// base/
+	//   root/infra/main.tf
+	//   root/inside -> root/infra      (루트 안을 가리키는 링크)
+	//   root/escape -> outside         (루트 밖을 가리키는 링크)
+	//   root/escape.tf -> outside/secret.tf
+	//   root/dangling.tf -> outside/missing.tf (루트 밖의 없는 파일을 가리키는 링크)
+	//   root/loop.tf -> root/loop.tf           (순환 링크)
+	//   root-other/main.tf             (이름이 루트로 시작하는 형제 디렉토리)
+	//   outside/secret.tf
+	root := filepath.Join(base, "root")
+	outside := filepath.Join(base, "outside")
+	for _, dir := range []string{filepath.Join(root, "infra"), outside, filepath.Join(base, "root-other")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range []string{filepath.Join(root, "infra", "main.tf"), filepath.Join(outside, "secret.tf"), filepath.Join(base, "root-other", "main.tf")} {
+		if err := os.WriteFile(file, []byte("# test\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		filepath.Join(root, "inside"):      filepath.Join(root, "infra"),
+		filepath.Join(root, "escape"):      outside,
+		filepath.Join(root, "escape.tf"):   filepath.Join(outside, "secret.tf"),
+		filepath.Join(root, "dangling.tf"): filepath.Join(outside, "missing.tf"),
+		filepath.Join(root, "loop.tf"):     filepath.Join(root, "loop.tf"),
+	}
+	for link, dest := range links {
+		if err := os.Symlink(dest, link); err != nil {
+			t.Skipf("symlinks not supported: %v", err)
+		}
+	}
+
+	h := &Handler{config: &config.Config{Server: config.ServerConfig{ScanRoots: []string{root}}}}
+
+	tests := []struct {
+		name       string
+		target     string
+		want       string
+		wantStatus int
+	}{
+		{name: "root", target: root, want: root, wantStatus: http.StatusOK},
+		{name: "file inside root", target: filepath.Join(root, "infra", "main.tf"), want: filepath.Join(root, "infra", "main.tf"), wantStatus: http.StatusOK},
+		{name: "link inside root", target: filepath.Join(root, "inside"), want: filepath.Join(root, "infra"), wantStatus: http.StatusOK},
+		{name: "dot segments inside root", target: filepath.Join(root, "infra", "..", "infra"), want: filepath.Join(root, "infra"), wantStatus: http.StatusOK},
+		{name: "directory link escape", target: filepath.Join(root, "escape"), wantStatus: http.StatusForbidden},
+		{name: "file through directory link", target: filepath.Join(root, "escape", "secret.tf"), wantStatus: http.StatusForbidden},
+		{name: "file link escape", target: filepath.Join(root, "escape.tf"), wantStatus: http.StatusForbidden},
+		{name: "parent directory escape", target: filepath.Join(root, "..", "outside"), wantStatus: http.StatusForbidden},
+		{name: "sibling with root prefix", target: filepath.Join(base, "root-other", "main.tf"), wantStatus: http.StatusForbidden},
+		{name: "missing path outside root", target: filepath.Join(outside, "missing.tf"), wantStatus: http.StatusForbidden},
+		{name: "missing path inside root", target: filepath.Join(root, "missing.tf"), wantStatus: http.StatusBadRequest},
+		{name: "missing path under link inside root", target: filepath.Join(root, "inside", "missing.tf"), wantStatus: http.StatusBadRequest},
+		{name: "missing path through directory link", target: filepath.Join(root, "escape", "missing.tf"), wantStatus: http.StatusForbidden},
+		{name: "missing directory through directory link", target: filepath.Join(root, "escape", "missing", "main.tf"), wantStatus: http.StatusForbidden},
+		{name: "dangling link escape", target: filepath.Join(root, "dangling.tf"), wantStatus: http.StatusForbidden},
+		{name: "link loop inside root", target: filepath.Join(root, "loop.tf"), wantStatus: http.StatusBadRequest},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPost, "/scan", nil)
+
+			got, status, err := h.resolveTarget(c, tt.target)
+			if status != tt.wantStatus {
+				t.Fatalf("resolveTarget(%q) status = %d, want %d (err = %v)", tt.target, status, tt.wantStatus, err)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if err == nil {
+					t.Errorf("resolveTarget(%q) error = nil, want error", tt.target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveTarget(%q) error = %v", tt.target, err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveTarget(%q) = %q, want %q", tt.target, got, tt.want)
+			}
+		})
+	}
+}
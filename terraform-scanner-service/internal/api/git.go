@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"terraform-scanner-service/internal/gitrepo"
+)
+
+// openSnapshot은 요청의 git 저장소에서 ref 시점의 트리를 읽습니다
+// base가 지정되면 base..ref 사이에서 변경된 모듈만 읽습니다
+// 저장소 경로도 허용된 스캔 루트 안에 있어야 합니다
+func (h *Handler) openSnapshot(c *gin.Context, task *scanTask) (int, error) {
+	repoPath, status, err := h.resolveTarget(c, task.req.Repo)
+	if err != nil {
+		return status, err
+	}
+
+	if task.req.Base != "" {
+		return h.openChanges(task, repoPath)
+	}
+	if task.req.DiffOnly {
+		return http.StatusBadRequest, fmt.Errorf("invalid request: diff_only requires base")
+	}
+
+	snapshot, err := gitrepo.Open(repoPath, task.req.Ref)
+	if err != nil {
+		return repoStatus(err)
+	}
+
+	task.target = repoPath
+	task.snapshot = snapshot
+	task.name = task.req.Repo + "@" + snapshot.Ref
+	return http.StatusOK, nil
+}
+
+// openChanges는 base와 ref 사이에서 변경된 모듈만 담은 ref 시점의 트리를 읽습니다
+func (h *Handler) openChanges(task *scanTask, repoPath string) (int, error) {
+	changes, err := gitrepo.Diff(repoPath, task.req.Base, task.req.Ref)
+	if err != nil {
+		return repoStatus(err)
+	}
+
+	task.target = repoPath
+	task.snapshot = changes.Head
+	task.changes = changes
+	task.name = task.req.Repo + "@" + changes.BaseRef + ".." + changes.Head.Ref
+	return http.StatusOK, nil
+}
+
+// repoStatus는 저장소 열기 오류를 응답 상태로 변환합니다
+func repoStatus(err error) (int, error) {
+	if errors.Is(err, gitrepo.ErrRefNotFound) {
+		return http.StatusBadRequest, err
+	}
+	return http.StatusBadRequest, fmt.Errorf("invalid repo: %w", err)
+}
@@ -1,9 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -11,33 +15,161 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 
+	"terraform-scanner-service/internal/compliance"
+	"terraform-scanner-service/internal/config"
+	"terraform-scanner-service/internal/gitrepo"
+	"terraform-scanner-service/internal/jobs"
+	"terraform-scanner-service/internal/report"
 	"terraform-scanner-service/internal/scanner"
+	"terraform-scanner-service/internal/store"
 	"terraform-scanner-service/internal/types"
 )
 
 // Handler는 HTTP 요청을 처리합니다
 type Handler struct {
 	scanner *scanner.TerraformScanner
+	config  *config.Config
+	specs   map[string]*compliance.Spec
+	queue   *jobs.Queue
+	store   *store.Store
+
+	// uploadDir은 요청별 업로드 작업 디렉토리를 만드는 이 프로세스의 디렉토리입니다
+	uploadDir string
 }
 
 // NewHandler는 Handler를 생성합니다
-func NewHandler(scanner *scanner.TerraformScanner) *Handler {
+func NewHandler(scanner *scanner.TerraformScanner, cfg *config.Config, specs map[string]*compliance.Spec, queue *jobs.Queue, store *store.Store, uploadDir string) *Handler {
 	return &Handler{
-		scanner: scanner,
+		scanner:   scanner,
+		config:    cfg,
+		specs:     specs,
+		queue:     queue,
+		store:     store,
+		uploadDir: uploadDir,
 	}
 }
 
 // ScanRequest는 스캔 요청 구조입니다
+// 필터 값은 JSON 본문, multipart 폼, 쿼리 파라미터로 전달할 수 있습니다
 type ScanRequest struct {
-	Target string `json:"target" binding:"required"`
+	Target        string   `json:"target" form:"target"`
+	Repo          string   `json:"repo" form:"repo"`
+	Ref           string   `json:"ref" form:"ref"`
+	Base          string   `json:"base" form:"base"`
+	DiffOnly      bool     `json:"diff_only" form:"diff_only"`
+	Profile       string   `json:"profile" form:"profile"`
+	Environment   string   `json:"environment" form:"environment"`
+	Compliance    string   `json:"compliance" form:"compliance"`
+	Inline        bool     `json:"inline" form:"inline"`
+	Format        string   `json:"format" form:"format"`
+	Template      string   `json:"template" form:"template"`
+	IncludePasses bool     `json:"include_passes" form:"include_passes"`
+	Save          *bool    `json:"save" form:"save"`
+	Include       []string `json:"include" form:"include"`
+	Exclude       []string `json:"exclude" form:"exclude"`
+	MinSeverity   string   `json:"min_severity" form:"min_severity"`
+	Providers     []string `json:"providers" form:"providers"`
+	Services      []string `json:"services" form:"services"`
+	SkipDirs      []string `json:"skip_dirs" form:"skip_dirs"`
+	SkipFiles     []string `json:"skip_files" form:"skip_files"`
+
+	// 기준선: 저장된 스캔 ID, JSON 본문, 또는 multipart의 baseline 파일로 전달
+	BaselineID string          `json:"baseline_id" form:"baseline_id"`
+	Baseline   *types.Baseline `json:"baseline,omitempty" form:"-"`
+}
+
+// scanOptions는 요청에서 스캔 옵션을 구성합니다
+// 프로파일이 지정되면 프로파일 설정 위에 요청의 필터를 적용합니다
+// format은 응답으로 렌더링할 형식이며, PASS 항목이 필요한 형식이면 통과 항목도 수집합니다
+func (h *Handler) scanOptions(req *ScanRequest, format string) (scanner.ScanOptions, error) {
+	opts, err := h.config.ScanOptions(&types.PolicyFilter{
+		Include:     req.Include,
+		Exclude:     req.Exclude,
+		MinSeverity: req.MinSeverity,
+		Providers:   req.Providers,
+		Services:    req.Services,
+	}, req.Profile, req.Environment)
+	if err != nil {
+		return scanner.ScanOptions{}, err
+	}
+	opts.IncludePasses = req.IncludePasses || report.RendersPasses(format)
+
+	if err := scanner.ValidateSkipPatterns(append(append([]string{}, req.SkipDirs...), req.SkipFiles...)); err != nil {
+		return scanner.ScanOptions{}, err
+	}
+	opts.SkipDirs = req.SkipDirs
+	opts.SkipFiles = req.SkipFiles
+
+	// 컴플라이언스 모드: 스펙에 연결된 정책 중 프로파일과 요청 필터를 만족하는 정책만 평가하고 통과 항목도 집계
+	if req.Compliance != "" {
+		spec, ok := h.specs[req.Compliance]
+		if !ok {
+			return scanner.ScanOptions{}, fmt.Errorf("unknown compliance spec: %s", req.Compliance)
+		}
+		opts.Filter = (&types.PolicyFilter{Include: spec.CheckIDs()}).Narrow(opts.Filter)
+		opts.IncludePasses = true
+	}
+
+	return opts, nil
+}
+
+// format은 출력 형식입니다 (기본값: json)
+func (r *ScanRequest) format() string {
+	if r.Format == "" {
+		return report.FormatJSON
+	}
+	return strings.ToLower(r.Format)
+}
+
+// save는 결과를 파일로 저장할지 여부입니다 (기본값: 저장)
+func (r *ScanRequest) save() bool {
+	return r.Save == nil || *r.Save
+}
+
+// sourceField는 요청에 지정된 스캔 대상 파라미터 이름을 반환합니다 (없으면 빈 문자열)
+func (r *ScanRequest) sourceField() string {
+	switch {
+	case r.Target != "":
+		return "target"
+	case r.Repo != "":
+		return "repo"
+	case r.Ref != "":
+		return "ref"
+	case r.Base != "":
+		return "base"
+	case r.DiffOnly:
+		return "diff_only"
+	}
+	return ""
 }
 
 // ScanResponse는 스캔 응답 구조입니다
 type ScanResponse struct {
-	Status  string       `json:"status"`
-	Results []ScanResult `json:"results,omitempty"`
-	Error   string       `json:"error,omitempty"`
+	Status     string              `json:"status"`
+	ScanID     string              `json:"scan_id,omitempty"`
+	Results    []ScanResult        `json:"results,omitempty"`
+	Reports    []*types.ScanResult `json:"reports,omitempty"`
+	Compliance *ComplianceResponse `json:"compliance,omitempty"`
+	Error      string              `json:"error,omitempty"`
+
+	// scanResults는 Accept 협상에 사용하는 전체 스캔 결과입니다
+	scanResults []*types.ScanResult
+
+	// passResults는 PASS 항목을 포함한 스캔 결과입니다 (요청하지 않은 PASS 항목을 수집한 경우)
+	// PASS 항목이 필요한 형식으로 렌더링할 때 사용합니다
+	passResults []*types.ScanResult
+
+	// format은 요청된 출력 형식입니다 (비어 있거나 json이면 Accept 협상)
+	format string
+	render report.Options
+}
+
+// ComplianceResponse는 컴플라이언스 모드의 리포트 응답입니다
+type ComplianceResponse struct {
+	File   string             `json:"file,omitempty"`
+	Report *compliance.Report `json:"report"`
 }
 
 // ScanResult는 개별 스캔 결과입니다
@@ -46,104 +178,353 @@ type ScanResult struct {
 	Target string `json:"target"`
 }
 
+// scanTask는 요청에서 준비된 스캔 작업입니다
+type scanTask struct {
+	target   string
+	name     string            // 기록용 대상 이름 (업로드 파일명 또는 요청 경로)
+	artifact string            // 업로드 작업 디렉토리를 스캔할 때 루트 모듈 이름
+	snapshot *gitrepo.Snapshot // git 저장소 스캔 시 ref 시점의 트리
+	changes  *gitrepo.Changes  // 변경분 스캔 (base 지정) 시 base..ref 변경 사항
+	req      ScanRequest
+	opts     scanner.ScanOptions
+	render   report.Options // 결과 렌더링 옵션 (템플릿 등)
+	cleanup  func()
+
+	// collectPasses는 요청하지 않은 PASS 항목을 렌더링용으로만 수집했는지 여부입니다 (비동기 작업)
+	collectPasses bool
+}
+
 // ScanTerraform은 Terraform 파일을 스캔합니다
 func (h *Handler) ScanTerraform(c *gin.Context) {
-	var targetPath string
+	task, status, err := h.prepareScan(c)
+	if err != nil {
+		c.JSON(status, ScanResponse{
+			Status: "error",
+			Error:  err.Error(),
+		})
+		return
+	}
+	defer task.cleanup()
+
+	// 스캔 실행
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.config.Server.ScanTimeout)
+	defer cancel()
 
-	// multipart file upload 처리
-	if file, err := c.FormFile("file"); err == nil {
-		// 임시 디렉토리에 파일 저장
-		tempDir := os.TempDir()
-		tempFile := filepath.Join(tempDir, file.Filename)
+	response, err := h.runScan(ctx, task)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ScanResponse{
+			Status: "error",
+			Error:  err.Error(),
+		})
+		return
+	}
 
-		if err := c.SaveUploadedFile(file, tempFile); err != nil {
-			c.JSON(http.StatusInternalServerError, ScanResponse{
-				Status: "error",
-				Error:  "failed to save uploaded file: " + err.Error(),
-			})
-			return
+	h.writeScanResponse(c, response)
+}
+
+// prepareScan은 요청을 검증하고 스캔 작업을 준비합니다
+// 에러가 있으면 응답할 HTTP 상태 코드를 함께 반환합니다
+// 준비 중 에러나 panic이 발생하면 업로드 작업 디렉토리를 정리합니다
+func (h *Handler) prepareScan(c *gin.Context) (*scanTask, int, error) {
+	task := &scanTask{cleanup: func() {}}
+	prepared := false
+	defer func() {
+		if !prepared {
+			task.cleanup()
 		}
+	}()
 
-		// 스캔 후 임시 파일 삭제
-		defer os.Remove(tempFile)
-		targetPath = tempFile
+	// multipart file upload 처리 (file 파트 여러 개, zip/tar.gz 압축 파일)
+	if c.ContentType() == binding.MIMEMultipartPOSTForm {
+		files, status, err := h.uploadedFiles(c)
+		if err != nil {
+			return nil, status, err
+		}
+		if err := c.ShouldBind(&task.req); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err)
+		}
+
+		// 요청별 작업 디렉토리에 저장 (스캔 후 삭제)
+		if status, err := h.saveUploads(task, files); err != nil {
+			return nil, status, err
+		}
 	} else {
 		// JSON 요청 처리
-		var req ScanRequest
-		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, ScanResponse{
-				Status: "error",
-				Error:  "invalid request: " + err.Error(),
-			})
-			return
+		if err := c.ShouldBindJSON(&task.req); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err)
+		}
+		if task.req.Target == "" && task.req.Repo == "" {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid request: target or repo is required")
+		}
+		if task.req.Target != "" && task.req.Repo != "" {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid request: specify only one of target, repo")
 		}
-		targetPath = req.Target
+	}
+
+	// 쿼리 파라미터 병합
+	if err := c.ShouldBindQuery(&task.req); err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid query: %w", err)
+	}
 
-		// 타겟 경로 확인
-		if _, err := os.Stat(targetPath); os.IsNotExist(err) {
-			c.JSON(http.StatusBadRequest, ScanResponse{
-				Status: "error",
-				Error:  "target path not found: " + targetPath,
-			})
-			return
+	// 업로드한 파일이 스캔 대상이므로 다른 대상 지정은 거부
+	if task.target != "" {
+		if field := task.req.sourceField(); field != "" {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid request: %s cannot be used with file uploads", field)
 		}
 	}
 
-	// 스캔 실행
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	switch {
+	case task.target != "":
+		// 업로드 작업 디렉토리
+	case task.req.Repo != "":
+		// git 저장소: ref 시점의 트리를 체크아웃 없이 읽음
+		if status, err := h.openSnapshot(c, task); err != nil {
+			return nil, status, err
+		}
+	case task.req.Ref != "":
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid request: ref requires repo")
+	case task.req.Base != "" || task.req.DiffOnly:
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid request: base and diff_only require repo")
+	default:
+		// 타겟 경로 확인 (허용된 스캔 루트 안의 실제 경로만 스캔)
+		target, status, err := h.resolveTarget(c, task.req.Target)
+		if err != nil {
+			return nil, status, err
+		}
+		task.target = target
+		task.name = task.req.Target
+	}
+
+	// 템플릿 파일 업로드 (multipart의 template_file)
+	if content, ok, err := readFormFile(c, "template_file"); err != nil {
+		return nil, http.StatusBadRequest, err
+	} else if ok {
+		task.req.Template = string(content)
+	}
 
-	results, err := h.scanner.ScanTarget(ctx, targetPath)
+	render, err := h.reportOptions(task.req.format(), task.req.Template, task.name)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ScanResponse{
-			Status: "error",
-			Error:  "scan failed: " + err.Error(),
-		})
-		return
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err)
 	}
+	task.render = render
 
-	// 결과 저장
-	savedFiles, err := h.saveResults(results)
+	opts, err := h.scanOptions(&task.req, h.responseFormat(c, &task.req))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ScanResponse{
-			Status: "error",
-			Error:  "failed to save results: " + err.Error(),
-		})
-		return
+		return nil, http.StatusBadRequest, err
 	}
 
-	c.JSON(http.StatusOK, ScanResponse{
-		Status:  "success",
-		Results: savedFiles,
-	})
+	baseline, status, err := h.loadBaseline(c, &task.req)
+	if err != nil {
+		return nil, status, err
+	}
+	opts.Baseline = baseline
+	opts.ArtifactName = task.artifact
+	task.opts = opts
+
+	prepared = true
+	return task, http.StatusOK, nil
 }
 
-// saveResults는 스캔 결과를 JSON 파일로 저장합니다
-func (h *Handler) saveResults(results []*types.ScanResult) ([]ScanResult, error) {
-	// 저장 디렉토리 생성
+// loadBaseline은 요청에 지정된 기준선을 불러옵니다 (지정하지 않으면 nil)
+func (h *Handler) loadBaseline(c *gin.Context, req *ScanRequest) (*types.Baseline, int, error) {
+	content, uploaded, err := readFormFile(c, "baseline")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	sources := 0
+	for _, set := range []bool{uploaded, req.BaselineID != "", req.Baseline != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid request: specify only one of baseline, baseline_id")
+	}
+
+	switch {
+	case uploaded:
+		baseline, err := scanner.ParseBaseline(content)
+		if err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+		return baseline, http.StatusOK, nil
+
+	case req.BaselineID != "":
+		record, err := h.store.Get(req.BaselineID)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, http.StatusBadRequest, fmt.Errorf("baseline scan not found: %s", req.BaselineID)
+		}
+		if err != nil {
+			return nil, http.StatusInternalServerError, fmt.Errorf("failed to load baseline scan: %w", err)
+		}
+		baseline := scanner.NewBaseline(record.Results)
+		baseline.ScanID = record.ID
+		return baseline, http.StatusOK, nil
+
+	case req.Baseline != nil:
+		if err := scanner.ValidateBaseline(req.Baseline); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+		return req.Baseline, http.StatusOK, nil
+	}
+
+	return nil, http.StatusOK, nil
+}
+
+// readFormFile은 multipart 요청에서 이름에 해당하는 파일 내용을 읽습니다
+// 파일이 없으면 false를 반환합니다
+func readFormFile(c *gin.Context, name string) ([]byte, bool, error) {
+	file, err := c.FormFile(name)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return content, true, nil
+}
+
+// scan은 작업 대상 (파일/디렉토리 경로 또는 git 스냅샷) 을 스캔합니다
+func (h *Handler) scan(ctx context.Context, task *scanTask) ([]*types.ScanResult, error) {
+	if task.snapshot == nil {
+		return h.scanner.ScanTarget(ctx, task.target, task.opts)
+	}
+	if task.changes != nil && task.snapshot.FS.Len() == 0 {
+		// 변경된 Terraform 모듈이 없으면 스캔할 대상도 없음
+		return []*types.ScanResult{}, nil
+	}
+
+	results, err := h.scanner.ScanFS(ctx, task.snapshot.FS, filepath.Base(task.target), task.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := task.snapshot.Metadata()
+	if task.changes != nil {
+		metadata = task.changes.Metadata()
+		if task.req.DiffOnly {
+			scanner.FilterChangedLines(results, task.changes.Lines)
+		}
+	}
+	for _, result := range results {
+		result.Metadata = metadata
+	}
+	return results, nil
+}
+
+// runScan은 스캔을 실행하고 결과를 저장합니다
+func (h *Handler) runScan(ctx context.Context, task *scanTask) (*ScanResponse, error) {
+	results, err := h.scan(ctx, task)
+	if err != nil {
+		return nil, fmt.Errorf("scan failed: %w", err)
+	}
+
+	// 렌더링용으로만 수집한 PASS 항목은 기록, 저장 파일, 응답 본문에서 제외
+	var passResults []*types.ScanResult
+	if task.collectPasses {
+		passResults = results
+		results = withoutPasses(results)
+	}
+
+	// 스캔 기록 저장 (고유 스캔 ID 발급)
+	options, _ := json.Marshal(task.req)
+	record := store.NewRecord(task.name, options, results)
+	if err := h.store.Save(record); err != nil {
+		return nil, fmt.Errorf("failed to save scan record: %w", err)
+	}
+
+	response := &ScanResponse{
+		Status:      "success",
+		ScanID:      record.ID,
+		scanResults: results,
+		passResults: passResults,
+		format:      task.req.format(),
+		render:      task.render,
+	}
+
+	// 결과 파일 저장 (save=false면 생략하고 본문에 결과 포함)
+	if task.req.save() {
+		savedFiles, err := h.saveResults(results, record.ID, task.req.format(), task.render)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save results: %w", err)
+		}
+		response.Results = savedFiles
+	}
+
+	if task.req.Inline || !task.req.save() {
+		response.Reports = results
+	}
+
+	// 컴플라이언스 리포트 생성
+	if task.req.Compliance != "" {
+		report := compliance.BuildReport(h.specs[task.req.Compliance], filepath.Base(task.name), results)
+		response.Compliance = &ComplianceResponse{Report: report}
+
+		if task.req.save() {
+			reportFile, err := h.saveComplianceReport(report, record.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to save compliance report: %w", err)
+			}
+			response.Compliance.File = reportFile
+		}
+	}
+
+	return response, nil
+}
+
+// resultDir은 오늘 날짜의 결과 저장 디렉토리를 생성하고 반환합니다
+func resultDir() (string, error) {
 	baseDir := "scan-results"
 	dateDir := time.Now().Format("2006-01-02")
 	saveDir := filepath.Join(baseDir, dateDir)
 
 	if err := os.MkdirAll(saveDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create directory: %w", err)
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	return saveDir, nil
+}
+
+// saveResults는 스캔 결과를 지정한 형식의 파일로 저장합니다
+// 파일명에 스캔 ID를 포함해 같은 이름의 대상도 덮어쓰지 않습니다
+func (h *Handler) saveResults(results []*types.ScanResult, scanID, format string, opts report.Options) ([]ScanResult, error) {
+	renderer, ok := report.Get(format)
+	if !ok {
+		return nil, errors.New(unsupportedFormat(format))
+	}
+
+	// 저장 디렉토리 생성
+	saveDir, err := resultDir()
+	if err != nil {
+		return nil, err
 	}
 
 	var savedFiles []ScanResult
 
 	for _, result := range results {
 		// 파일명 생성
+		// 하위 모듈 이름 (예: envs/prod) 의 경로 구분자는 파일명에 쓸 수 없으므로 치환
 		fileName := strings.TrimSuffix(result.ArtifactName, filepath.Ext(result.ArtifactName))
-		resultFile := filepath.Join(saveDir, fmt.Sprintf("%s-scan-result.json", fileName))
+		fileName = strings.ReplaceAll(fileName, "/", "_")
+		resultFile := filepath.Join(saveDir, fmt.Sprintf("%s-%s-scan-result.%s", fileName, scanID, renderer.Extension()))
 
-		// JSON으로 직렬화
-		data, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal result: %w", err)
+		// 형식에 맞게 렌더링
+		var buf bytes.Buffer
+		if err := renderer.Render(&buf, []*types.ScanResult{result}, opts); err != nil {
+			return nil, fmt.Errorf("failed to render result: %w", err)
 		}
 
 		// 파일 저장
-		if err := os.WriteFile(resultFile, data, 0644); err != nil {
+		if err := os.WriteFile(resultFile, buf.Bytes(), 0644); err != nil {
 			return nil, fmt.Errorf("failed to write file: %w", err)
 		}
 
@@ -152,17 +533,42 @@ func (h *Handler) saveResults(results []*types.ScanResult) ([]ScanResult, error)
 			Target: result.ArtifactName,
 		})
 
-		fmt.Printf("Scan result saved: %s\n", resultFile)
+		log.Printf("Scan result saved: %s\n", resultFile)
 	}
 
 	return savedFiles, nil
 }
 
+// saveComplianceReport는 컴플라이언스 리포트를 JSON 파일로 저장합니다
+func (h *Handler) saveComplianceReport(report *compliance.Report, scanID string) (string, error) {
+	saveDir, err := resultDir()
+	if err != nil {
+		return "", err
+	}
+
+	fileName := strings.TrimSuffix(report.ArtifactName, filepath.Ext(report.ArtifactName))
+	reportFile := filepath.Join(saveDir, fmt.Sprintf("%s-%s-%s-compliance.json", fileName, scanID, report.ID))
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	if err := os.WriteFile(reportFile, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	log.Printf("Compliance report saved: %s\n", reportFile)
+	return reportFile, nil
+}
+
 // HealthCheck는 서비스 상태를 확인합니다
 func (h *Handler) HealthCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"status":          "healthy",
 		"policies_loaded": h.scanner.PolicyCount(),
+		"policy_revision": h.scanner.PolicyRevision(),
+		"cache":           h.scanner.CacheStats(),
 		"timestamp":       time.Now().Format(time.RFC3339),
 	})
 }
@@ -176,3 +582,47 @@ func (h *Handler) ListPolicies(c *gin.Context) {
 		"policies": policies,
 	})
 }
+
+// ReloadPolicies는 정책 디렉토리에서 정책을 다시 로드하고 결과 캐시를 무효화합니다
+// 로드에 실패하면 기존 정책을 그대로 사용합니다
+func (h *Handler) ReloadPolicies(c *gin.Context) {
+	if err := h.scanner.ReloadPolicies(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status": "error",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":          "success",
+		"policies_loaded": h.scanner.PolicyCount(),
+		"policy_revision": h.scanner.PolicyRevision(),
+	})
+}
+
+// ListProfiles는 서버에 정의된 프로파일 목록을 반환합니다
+func (h *Handler) ListProfiles(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"count":    len(h.config.Profiles),
+		"profiles": h.config.Profiles,
+	})
+}
+
+// ListCompliance는 로드된 컴플라이언스 스펙 목록을 반환합니다
+func (h *Handler) ListCompliance(c *gin.Context) {
+	specs := make([]gin.H, 0, len(h.specs))
+	for _, spec := range h.specs {
+		specs = append(specs, gin.H{
+			"id":       spec.Spec.ID,
+			"title":    spec.Spec.Title,
+			"version":  spec.Spec.Version,
+			"controls": len(spec.Spec.Controls),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count": len(specs),
+		"specs": specs,
+	})
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"terraform-scanner-service/internal/jobs"
+)
+
+// JobResponse는 비동기 스캔 작업 응답입니다
+type JobResponse struct {
+	Status string     `json:"status"`
+	Job    *jobs.Info `json:"job,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// SubmitScan은 스캔을 비동기 작업으로 등록하고 작업 ID를 반환합니다
+func (h *Handler) SubmitScan(c *gin.Context) {
+	task, status, err := h.prepareScan(c)
+	if err != nil {
+		c.JSON(status, JobResponse{
+			Status: "error",
+			Error:  err.Error(),
+		})
+		return
+	}
+
+	// 결과 조회 시 Accept 헤더로 형식을 다시 협상하므로, PASS 항목이 필요한 형식을 위해 항상 수집
+	task.collectPasses = !task.opts.IncludePasses
+	task.opts.IncludePasses = true
+
+	job, err := h.queue.Submit(func(ctx context.Context, progress jobs.ProgressFunc) (interface{}, error) {
+		task.opts.Progress = progress
+		return h.runScan(ctx, task)
+	}, task.cleanup)
+	if err != nil {
+		task.cleanup()
+		c.JSON(http.StatusServiceUnavailable, JobResponse{
+			Status: "error",
+			Error:  err.Error(),
+		})
+		return
+	}
+
+	info := job.Info()
+	c.Header("Location", "/scans/"+job.ID())
+	c.JSON(http.StatusAccepted, JobResponse{
+		Status: "accepted",
+		Job:    &info,
+	})
+}
+
+// GetScan은 작업 상태와 진행 상황을 반환합니다
+func (h *Handler) GetScan(c *gin.Context) {
+	job, ok := h.queue.Get(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, JobResponse{
+			Status: "error",
+			Error:  "job not found: " + c.Param("id"),
+		})
+		return
+	}
+
+	info := job.Info()
+	c.JSON(http.StatusOK, JobResponse{
+		Status: "success",
+		Job:    &info,
+	})
+}
+
+// GetScanResult는 완료된 작업의 스캔 결과를 반환합니다
+func (h *Handler) GetScanResult(c *gin.Context) {
+	job, ok := h.queue.Get(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, ScanResponse{
+			Status: "error",
+			Error:  "job not found: " + c.Param("id"),
+		})
+		return
+	}
+
+	result, status, err := job.Result()
+	switch status {
+	case jobs.StatusCompleted:
+		h.writeScanResponse(c, result.(*ScanResponse))
+	case jobs.StatusQueued, jobs.StatusRunning:
+		info := job.Info()
+		c.JSON(http.StatusAccepted, JobResponse{
+			Status: string(status),
+			Job:    &info,
+		})
+	default:
+		c.JSON(http.StatusConflict, ScanResponse{
+			Status: "error",
+			Error:  "job " + string(status) + ": " + errorString(err),
+		})
+	}
+}
+
+// CancelScan은 대기 중이거나 실행 중인 작업을 취소합니다
+func (h *Handler) CancelScan(c *gin.Context) {
+	job, err := h.queue.Cancel(c.Param("id"))
+	if job == nil {
+		c.JSON(http.StatusNotFound, JobResponse{
+			Status: "error",
+			Error:  err.Error(),
+		})
+		return
+	}
+
+	info := job.Info()
+	if errors.Is(err, jobs.ErrJobFinished) {
+		c.JSON(http.StatusConflict, JobResponse{
+			Status: "error",
+			Job:    &info,
+			Error:  err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, JobResponse{
+		Status: "canceling",
+		Job:    &info,
+	})
+}
+
+// errorString은 nil 에러를 빈 문자열로 변환합니다
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"terraform-scanner-service/internal/report"
+	"terraform-scanner-service/internal/types"
+)
+
+// MIMETrivyJSON은 Trivy 형식의 스캔 결과 문서를 요청하는 미디어 타입입니다
+const MIMETrivyJSON = "application/vnd.trivy+json"
+
+// writeScanResponse는 format 파라미터 또는 Accept 헤더에 따라 스캔 응답을 작성합니다
+//   - application/json (기본값): 상태와 저장 경로를 담은 ScanResponse
+//   - application/vnd.trivy+json: Trivy 형식 ScanResult 문서 (결과가 하나면 객체, 여러 개면 배열)
+//   - format=<형식> 또는 해당 형식의 미디어 타입 (예: application/sarif+json): 렌더링된 문서
+func (h *Handler) writeScanResponse(c *gin.Context, response *ScanResponse) {
+	if response.format != "" && response.format != report.FormatJSON {
+		h.writeReport(c, response.format, response.resultsFor(response.format), response.ScanID, response.render)
+		return
+	}
+
+	switch accepted := c.NegotiateFormat(h.offeredFormats()...); accepted {
+	case gin.MIMEJSON:
+		c.JSON(http.StatusOK, response)
+
+	case MIMETrivyJSON:
+		c.Header("Content-Type", MIMETrivyJSON)
+		if len(response.scanResults) == 1 {
+			c.JSON(http.StatusOK, response.scanResults[0])
+		} else {
+			c.JSON(http.StatusOK, response.scanResults)
+		}
+
+	case "":
+		c.JSON(http.StatusNotAcceptable, ScanResponse{
+			Status: "error",
+			Error:  "unsupported Accept header: " + c.GetHeader("Accept"),
+		})
+
+	default:
+		format, _ := report.ForContentType(accepted)
+		h.writeReport(c, format, response.resultsFor(format), response.ScanID, response.render)
+	}
+}
+
+// resultsFor는 format으로 렌더링할 스캔 결과를 반환합니다
+// PASS 항목이 필요한 형식이면 따로 수집해 둔 PASS 항목 포함 결과를 사용합니다
+func (r *ScanResponse) resultsFor(format string) []*types.ScanResult {
+	if r.passResults != nil && report.RendersPasses(format) {
+		return r.passResults
+	}
+	return r.scanResults
+}
+
+// withoutPasses는 PASS 항목을 제외한 스캔 결과 사본을 반환합니다
+func withoutPasses(results []*types.ScanResult) []*types.ScanResult {
+	filtered := make([]*types.ScanResult, len(results))
+	for i, result := range results {
+		copied := *result
+		copied.Results = make([]types.Result, len(result.Results))
+		for j, r := range result.Results {
+			var failed []types.Misconfiguration
+			for _, misconfig := range r.Misconfigurations {
+				if misconfig.Status != "PASS" {
+					failed = append(failed, misconfig)
+				}
+			}
+			r.Misconfigurations = failed
+			copied.Results[j] = r
+		}
+		filtered[i] = &copied
+	}
+	return filtered
+}
+
+// responseFormat은 스캔 응답으로 렌더링할 형식을 반환합니다
+// format 파라미터가 없으면 Accept 협상 결과를 사용하며, JSON 응답이면 json을 반환합니다
+func (h *Handler) responseFormat(c *gin.Context, req *ScanRequest) string {
+	if format := req.format(); format != report.FormatJSON {
+		return format
+	}
+	format, _ := report.ForContentType(c.NegotiateFormat(h.offeredFormats()...))
+	if format == "" {
+		return report.FormatJSON
+	}
+	return format
+}
+
+// offeredFormats는 Accept 협상에 제공하는 미디어 타입 목록입니다
+// JSON이 기본값이 되도록 가장 먼저 둡니다
+func (h *Handler) offeredFormats() []string {
+	offers := []string{gin.MIMEJSON, MIMETrivyJSON}
+	for _, contentType := range report.ContentTypes() {
+		if contentType != gin.MIMEJSON {
+			offers = append(offers, contentType)
+		}
+	}
+	return offers
+}
+
+// writeReport는 스캔 결과를 지정한 형식으로 렌더링해 응답합니다
+func (h *Handler) writeReport(c *gin.Context, format string, results []*types.ScanResult, scanID string, opts report.Options) {
+	renderer, ok := report.Get(format)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ScanResponse{
+			Status: "error",
+			Error:  unsupportedFormat(format),
+		})
+		return
+	}
+
+	// 응답 본문은 저장되는 파일과 같도록 기본적으로 색상 없음 (color=true로 켬)
+	opts.Color = c.Query("color") == "true"
+
+	var buf bytes.Buffer
+	if err := renderer.Render(&buf, results, opts); err != nil {
+		c.JSON(http.StatusInternalServerError, ScanResponse{
+			Status: "error",
+			Error:  "failed to render " + format + ": " + err.Error(),
+		})
+		return
+	}
+
+	if scanID != "" {
+		c.Header("X-Scan-ID", scanID)
+	}
+	c.Data(http.StatusOK, renderer.ContentType(), buf.Bytes())
+}
+
+// reportOptions는 형식을 검증하고 렌더링 옵션을 구성합니다
+// template 형식이면 템플릿 ("@이름" 또는 템플릿 내용) 을 불러와 미리 파싱합니다
+func (h *Handler) reportOptions(format, tmpl, artifactName string) (report.Options, error) {
+	if _, ok := report.Get(format); !ok {
+		return report.Options{}, errors.New(unsupportedFormat(format))
+	}
+
+	policies := make(map[string]*types.PolicyMetadata)
+	for _, meta := range h.scanner.GetPolicies() {
+		policies[meta.ID] = meta
+	}
+	opts := report.Options{
+		Policies:     policies,
+		ArtifactName: filepath.Base(artifactName),
+	}
+
+	if format == report.FormatTemplate {
+		if tmpl == "" {
+			return report.Options{}, errors.New("template is required for format template")
+		}
+
+		text, err := report.ResolveTemplate(tmpl)
+		if err != nil {
+			return report.Options{}, err
+		}
+		if _, err := report.ParseTemplate(text); err != nil {
+			return report.Options{}, err
+		}
+		opts.Template = text
+	}
+
+	return opts, nil
+}
+
+// unsupportedFormat은 지원하지 않는 형식에 대한 에러 메시지입니다
+func unsupportedFormat(format string) string {
+	return "unsupported format: " + format + " (available: " + strings.Join(report.Formats(), ", ") + ")"
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"terraform-scanner-service/internal/report"
+	"terraform-scanner-service/internal/scanner"
+	"terraform-scanner-service/internal/store"
+	"terraform-scanner-service/internal/types"
+)
+
+// 스캔 기록 조회 페이지 크기
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// ListResults는 저장된 스캔 기록을 조건에 따라 최신순으로 반환합니다
+func (h *Handler) ListResults(c *gin.Context) {
+	query, err := parseResultQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status": "error",
+			"error":  "invalid query: " + err.Error(),
+		})
+		return
+	}
+
+	metas, total, err := h.store.List(query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status": "error",
+			"error":  "failed to list results: " + err.Error(),
+		})
+		return
+	}
+
+	if metas == nil {
+		metas = []store.Meta{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":   len(metas),
+		"total":   total,
+		"limit":   query.Limit,
+		"offset":  query.Offset,
+		"results": metas,
+	})
+}
+
+// GetResult는 스캔 기록을 ID로 조회합니다
+// format 파라미터나 Accept 헤더로 형식을 지정하면 결과를 해당 형식으로 렌더링합니다
+func (h *Handler) GetResult(c *gin.Context) {
+	record, ok := h.getRecord(c, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		format, _ = report.ForContentType(c.NegotiateFormat(h.offeredFormats()...))
+	}
+
+	if format == "" || format == report.FormatJSON {
+		c.JSON(http.StatusOK, record)
+		return
+	}
+
+	opts, err := h.reportOptions(format, c.Query("template"), record.Target)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status": "error",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	h.writeReport(c, format, record.Results, record.ID, opts)
+}
+
+// CompareResults는 두 스캔 기록을 비교해 새로 발견된, 해결된, 유지된 문제를 반환합니다
+func (h *Handler) CompareResults(c *gin.Context) {
+	baseID, headID := c.Query("base"), c.Query("head")
+	if baseID == "" || headID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status": "error",
+			"error":  "base and head scan IDs are required",
+		})
+		return
+	}
+
+	base, ok := h.getRecord(c, baseID)
+	if !ok {
+		return
+	}
+	head, ok := h.getRecord(c, headID)
+	if !ok {
+		return
+	}
+
+	comparison := scanner.Compare(base.Results, head.Results)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":     "success",
+		"base":       base.Meta,
+		"head":       head.Meta,
+		"summary":    comparison.Summary,
+		"new":        comparison.New,
+		"resolved":   comparison.Resolved,
+		"persisting": comparison.Persisting,
+	})
+}
+
+// GetBaseline은 스캔 기록의 실패 항목으로 기준선 파일을 생성합니다
+func (h *Handler) GetBaseline(c *gin.Context) {
+	record, ok := h.getRecord(c, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	baseline := scanner.NewBaseline(record.Results)
+	baseline.ScanID = record.ID
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=baseline-%s.json", record.ID))
+	c.IndentedJSON(http.StatusOK, baseline)
+}
+
+// GetHTMLReport는 스캔 기록을 단일 HTML 리포트로 반환합니다
+func (h *Handler) GetHTMLReport(c *gin.Context) {
+	record, ok := h.getRecord(c, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	opts, err := h.reportOptions("html", "", record.Target)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status": "error",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	h.writeReport(c, "html", record.Results, record.ID, opts)
+}
+
+// getRecord는 스캔 기록을 조회하고, 실패하면 에러 응답을 작성합니다
+func (h *Handler) getRecord(c *gin.Context, id string) (*store.Record, bool) {
+	record, err := h.store.Get(id)
+	if errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"status": "error",
+			"error":  "scan not found: " + id,
+		})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"status": "error",
+			"error":  "failed to load result: " + err.Error(),
+		})
+		return nil, false
+	}
+
+	return record, true
+}
+
+// parseResultQuery는 쿼리 파라미터에서 조회 조건을 구성합니다
+func parseResultQuery(c *gin.Context) (store.Query, error) {
+	query := store.Query{
+		Artifact: c.Query("artifact"),
+		Limit:    defaultPageSize,
+	}
+
+	if since := c.Query("since"); since != "" {
+		t, err := parseTime(since, false)
+		if err != nil {
+			return query, fmt.Errorf("since: %w", err)
+		}
+		query.Since = t
+	}
+
+	if until := c.Query("until"); until != "" {
+		t, err := parseTime(until, true)
+		if err != nil {
+			return query, fmt.Errorf("until: %w", err)
+		}
+		query.Until = t
+	}
+
+	if severity := c.Query("severity"); severity != "" {
+		if !types.IsValidSeverity(severity) {
+			return query, fmt.Errorf("invalid severity: %s", severity)
+		}
+		query.Severity = severity
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return query, fmt.Errorf("invalid limit: %s", limit)
+		}
+		query.Limit = min(n, maxPageSize)
+	}
+
+	if offset := c.Query("offset"); offset != "" {
+		n, err := strconv.Atoi(offset)
+		if err != nil || n < 0 {
+			return query, fmt.Errorf("invalid offset: %s", offset)
+		}
+		query.Offset = n
+	}
+
+	return query, nil
+}
+
+// parseTime은 날짜 (YYYY-MM-DD) 또는 RFC3339 시각을 파싱합니다
+// endOfDay가 true면 날짜만 지정된 경우 해당 일의 마지막 시각을 반환합니다
+func parseTime(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected YYYY-MM-DD or RFC3339: %s", value)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return t, nil
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"terraform-scanner-service/internal/upload"
+)
+
+// multipartOverhead는 요청 본문 크기 제한에 더하는 multipart 헤더, 폼 필드 여유분입니다
+const multipartOverhead = 1 << 20
+
+// uploadedFiles는 multipart 요청의 file 파트 목록을 반환합니다
+// 요청 본문은 업로드 크기 제한으로 읽기를 제한합니다
+func (h *Handler) uploadedFiles(c *gin.Context) ([]*multipart.FileHeader, int, error) {
+	limits := h.config.Uploads.Limits()
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limits.MaxBytes+multipartOverhead)
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return nil, http.StatusRequestEntityTooLarge, fmt.Errorf("upload too large: limit is %d bytes", limits.MaxBytes)
+		}
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid multipart request: %w", err)
+	}
+
+	files := form.File["file"]
+	if len(files) == 0 {
+		return nil, http.StatusBadRequest, errors.New("invalid request: file is required")
+	}
+	return files, http.StatusOK, nil
+}
+
+// saveUploads는 업로드 파일을 요청별 작업 디렉토리에 저장하고 압축 파일은 풉니다
+// 압축 파일이 아닌 파일 하나만 업로드되면 그 파일을, 그 외에는 작업 디렉토리 전체를 스캔합니다
+func (h *Handler) saveUploads(task *scanTask, files []*multipart.FileHeader) (int, error) {
+	sandbox, err := upload.NewSandbox(h.uploadDir, h.config.Uploads.Limits())
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	task.cleanup = sandbox.Remove
+
+	var saved string
+	for _, file := range files {
+		if saved, err = addUpload(sandbox, file); err != nil {
+			return uploadStatus(err), fmt.Errorf("invalid upload %s: %w", file.Filename, err)
+		}
+	}
+
+	// 저장 파일명은 정리된 이름이지만, 결과에는 원래 파일명을 보고
+	task.target = sandbox.Dir
+	task.name = "upload"
+	task.artifact = task.name
+	if len(files) == 1 {
+		task.name = upload.DisplayName(files[0].Filename)
+		task.artifact = upload.TrimArchiveExt(task.name)
+		if !upload.IsArchive(files[0].Filename) {
+			task.target = saved
+			task.artifact = task.name
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// addUpload는 업로드 파일 하나를 작업 디렉토리에 추가합니다
+func addUpload(sandbox *upload.Sandbox, file *multipart.FileHeader) (string, error) {
+	f, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open uploaded file: %w", err)
+	}
+	defer f.Close()
+
+	return sandbox.Add(file.Filename, f, file.Size)
+}
+
+// uploadStatus는 업로드 에러에 맞는 HTTP 상태 코드를 반환합니다
+func uploadStatus(err error) int {
+	if errors.Is(err, upload.ErrLimitExceeded) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// 종료 코드
+const (
+	exitOK    = 0
+	exitError = 2 // 사용법 오류 또는 스캔 실패
+)
+
+const usage = `Usage: scanner <command> [flags]
+
+Commands:
+  serve            Start the HTTP API server (default)
+  scan <path>      Scan a Terraform file or directory
+  policies list    List loaded policies
+
+Run "scanner <command> -h" for command flags.
+`
+
+// Run은 명령줄 인자를 해석해 하위 명령을 실행하고 종료 코드를 반환합니다
+// 인자가 없으면 기존과 같이 API 서버를 시작합니다
+func Run(args []string) int {
+	if len(args) == 0 {
+		return runServe(nil)
+	}
+
+	command, rest := args[0], args[1:]
+	switch command {
+	case "serve":
+		return runServe(rest)
+	case "scan":
+		return runScan(rest)
+	case "policies":
+		return runPolicies(rest)
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return exitOK
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n\n%s", command, usage)
+		return exitError
+	}
+}
+
+// envOr는 환경변수 값을 반환하고, 없으면 기본값을 반환합니다
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// splitList는 쉼표로 구분된 값을 목록으로 변환합니다
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// fail은 에러를 표준 에러로 출력하고 에러 종료 코드를 반환합니다
+func fail(err error) int {
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	return exitError
+}
+
+// parseArgs는 플래그와 위치 인자가 섞여 있어도 모두 해석하고 위치 인자를 반환합니다
+// (예: scanner scan ./infra --format table)
+func parseArgs(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		if flags.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"terraform-scanner-service/internal/scanner"
+	"terraform-scanner-service/internal/types"
+)
+
+// runPolicies는 정책 관련 하위 명령을 실행합니다
+func runPolicies(args []string) int {
+	if len(args) == 0 || args[0] != "list" {
+		fmt.Fprintln(os.Stderr, "Usage: scanner policies list [flags]")
+		return exitError
+	}
+
+	flags := flag.NewFlagSet("policies list", flag.ContinueOnError)
+	policyDir := flags.String("policy-dir", envOr("POLICY_DIR", "../trivy-checks-source/checks"), "policy directory")
+	format := flags.String("format", "table", "output format (table, json)")
+	verbose := flags.Bool("v", false, "print scanner logs to stderr")
+	if err := flags.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitError
+	}
+
+	if *format != "table" && *format != "json" {
+		return fail(fmt.Errorf("unsupported format: %s (available: table, json)", *format))
+	}
+
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	tfScanner, err := scanner.NewTerraformScanner(*policyDir)
+	if err != nil {
+		return fail(fmt.Errorf("failed to initialize scanner: %w", err))
+	}
+
+	policies := tfScanner.GetPolicies()
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].ID < policies[j].ID
+	})
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(policies); err != nil {
+			return fail(err)
+		}
+		return exitOK
+	}
+
+	writePolicyTable(os.Stdout, policies)
+	return exitOK
+}
+
+// writePolicyTable은 정책 목록을 표 형식으로 출력합니다
+func writePolicyTable(w io.Writer, policies []*types.PolicyMetadata) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSEVERITY\tPROVIDER\tSERVICE\tTITLE")
+	for _, policy := range policies {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", policy.ID, policy.Severity, policy.Provider, policy.Service, policy.Title)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\n%d policies\n", len(policies))
+}
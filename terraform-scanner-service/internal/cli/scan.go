@@ -0,0 +1,357 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"terraform-scanner-service/internal/config"
+	"terraform-scanner-service/internal/gitrepo"
+	"terraform-scanner-service/internal/report"
+	"terraform-scanner-service/internal/scanner"
+	"terraform-scanner-service/internal/types"
+)
+
+// scanFlags는 scan 명령의 옵션입니다
+type scanFlags struct {
+	policyDir     string
+	configFile    string
+	templateDir   string
+	ref           string
+	base          string
+	diffOnly      bool
+	watch         bool
+	debounce      time.Duration
+	format        string
+	output        string
+	template      string
+	noColor       bool
+	profile       string
+	environment   string
+	include       string
+	exclude       string
+	minSeverity   string
+	providers     string
+	services      string
+	skipDirs      string
+	skipFiles     string
+	includePasses bool
+	baseline      string
+	exitOn        string
+	exitCode      int
+	verbose       bool
+}
+
+// runScan은 서버 없이 파일 또는 디렉토리를 스캔하고 결과를 출력합니다
+// exit-on 심각도 이상의 문제가 발견되면 exit-code로 종료합니다
+func runScan(args []string) int {
+	f := &scanFlags{}
+	flags := flag.NewFlagSet("scan", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: scanner scan [flags] <path>")
+		fmt.Fprintln(flags.Output(), "       scanner scan --ref <ref> [flags] <repo>")
+		fmt.Fprintln(flags.Output(), "       scanner scan --base <ref> [--ref <ref>] [--diff-only] [flags] <repo>")
+		fmt.Fprintln(flags.Output(), "       scanner scan --watch [flags] <dir>")
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&f.policyDir, "policy-dir", envOr("POLICY_DIR", "../trivy-checks-source/checks"), "policy directory")
+	flags.StringVar(&f.configFile, "config", envOr("CONFIG_FILE", "config/scanner.yaml"), "config file")
+	flags.StringVar(&f.templateDir, "template-dir", envOr("TEMPLATE_DIR", "templates"), "named output template directory")
+	flags.StringVar(&f.ref, "ref", "", "scan a git repository at this ref (branch, tag or commit) without checking it out")
+	flags.StringVar(&f.base, "base", "", "scan only modules changed between this ref and --ref (default HEAD)")
+	flags.BoolVar(&f.diffOnly, "diff-only", false, "with --base, report only findings on changed lines")
+	flags.BoolVar(&f.watch, "watch", false, "watch the directory and rescan changed modules, printing new and resolved findings")
+	flags.DurationVar(&f.debounce, "debounce", 500*time.Millisecond, "with --watch, wait this long after the last change before rescanning")
+	flags.StringVar(&f.format, "format", report.FormatJSON, "output format ("+strings.Join(report.Formats(), ", ")+")")
+	flags.StringVar(&f.output, "output", "", "write the report to a file instead of stdout")
+	flags.StringVar(&f.template, "template", "", "template for format template (@name, @path or inline text)")
+	flags.BoolVar(&f.noColor, "no-color", false, "disable colored output")
+	flags.StringVar(&f.profile, "profile", "", "scan profile from the config file")
+	flags.StringVar(&f.environment, "environment", "", "scan environment for conditional severity overrides")
+	flags.StringVar(&f.include, "include", "", "comma-separated policy IDs or globs to include")
+	flags.StringVar(&f.exclude, "exclude", "", "comma-separated policy IDs or globs to exclude")
+	flags.StringVar(&f.minSeverity, "min-severity", "", "minimum policy severity to evaluate")
+	flags.StringVar(&f.providers, "providers", "", "comma-separated providers to evaluate")
+	flags.StringVar(&f.services, "services", "", "comma-separated services to evaluate")
+	flags.StringVar(&f.skipDirs, "skip-dirs", "", "comma-separated directory globs to skip (.terraform and .git are always skipped)")
+	flags.StringVar(&f.skipFiles, "skip-files", "", "comma-separated file globs to skip")
+	flags.BoolVar(&f.includePasses, "include-passes", false, "include passed policies in the report")
+	flags.StringVar(&f.baseline, "baseline", "", "baseline file of accepted findings")
+	flags.StringVar(&f.exitOn, "exit-on", "", "exit with exit-code when findings at or above this severity exist")
+	flags.IntVar(&f.exitCode, "exit-code", 1, "exit code used with exit-on")
+	flags.BoolVar(&f.verbose, "v", false, "print scanner logs to stderr")
+	targets, err := parseArgs(flags, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitError
+	}
+
+	if len(targets) != 1 {
+		flags.Usage()
+		return exitError
+	}
+
+	if !f.verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	if f.watch {
+		if err := watch(targets[0], f); err != nil {
+			return fail(err)
+		}
+		return exitOK
+	}
+
+	failed, err := scan(targets[0], f)
+	if err != nil {
+		return fail(err)
+	}
+	if failed {
+		return f.exitCode
+	}
+	return exitOK
+}
+
+// scanSession은 플래그로 구성한 스캐너와 스캔, 렌더링 옵션입니다
+type scanSession struct {
+	scanner *scanner.TerraformScanner
+	opts    scanner.ScanOptions
+	render  report.Options
+	format  string
+	exitOn  string
+}
+
+// scan은 스캔을 실행해 리포트를 출력하고, exit-on 기준을 넘는 문제가 있는지 반환합니다
+func scan(target string, f *scanFlags) (bool, error) {
+	s, err := newScanSession(target, f)
+	if err != nil {
+		return false, err
+	}
+
+	results, err := scanTarget(s.scanner, target, f, s.opts)
+	if err != nil {
+		return false, err
+	}
+
+	if err := writeReport(s.format, results, s.render, f.output); err != nil {
+		return false, err
+	}
+
+	return s.exitOn != "" && hasFindings(results, s.exitOn), nil
+}
+
+// newScanSession은 플래그를 검증하고 설정, 정책을 로드해 스캔을 준비합니다
+func newScanSession(target string, f *scanFlags) (*scanSession, error) {
+	format := strings.ToLower(f.format)
+	if _, ok := report.Get(format); !ok {
+		return nil, fmt.Errorf("unsupported format: %s (available: %s)", format, strings.Join(report.Formats(), ", "))
+	}
+
+	if f.diffOnly && f.base == "" {
+		return nil, fmt.Errorf("--diff-only requires --base")
+	}
+
+	exitOn := strings.ToUpper(f.exitOn)
+	if exitOn != "" && !types.IsValidSeverity(exitOn) {
+		return nil, fmt.Errorf("invalid exit-on severity: %s", f.exitOn)
+	}
+
+	cfg, err := config.Load(f.configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := cfg.ScanOptions(&types.PolicyFilter{
+		Include:     splitList(f.include),
+		Exclude:     splitList(f.exclude),
+		MinSeverity: f.minSeverity,
+		Providers:   splitList(f.providers),
+		Services:    splitList(f.services),
+	}, f.profile, f.environment)
+	if err != nil {
+		return nil, err
+	}
+	opts.IncludePasses = f.includePasses || report.RendersPasses(format)
+	opts.SkipDirs = splitList(f.skipDirs)
+	opts.SkipFiles = splitList(f.skipFiles)
+	if err := scanner.ValidateSkipPatterns(append(append([]string{}, opts.SkipDirs...), opts.SkipFiles...)); err != nil {
+		return nil, err
+	}
+
+	if f.baseline != "" {
+		content, err := os.ReadFile(f.baseline)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read baseline: %w", err)
+		}
+		if opts.Baseline, err = scanner.ParseBaseline(content); err != nil {
+			return nil, err
+		}
+	}
+
+	tfScanner, err := scanner.NewTerraformScanner(f.policyDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize scanner: %w", err)
+	}
+
+	renderOpts, err := renderOptions(tfScanner, format, target, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scanSession{
+		scanner: tfScanner,
+		opts:    opts,
+		render:  renderOpts,
+		format:  format,
+		exitOn:  exitOn,
+	}, nil
+}
+
+// scanTarget은 경로를 스캔하거나, ref가 지정되면 git 저장소의 ref 시점 트리를 스캔합니다
+// base가 지정되면 base..ref 사이에서 변경된 모듈만 스캔합니다
+func scanTarget(tfScanner *scanner.TerraformScanner, target string, f *scanFlags, opts scanner.ScanOptions) ([]*types.ScanResult, error) {
+	if f.ref == "" && f.base == "" {
+		return tfScanner.ScanTarget(context.Background(), target, opts)
+	}
+
+	var (
+		snapshot *gitrepo.Snapshot
+		changes  *gitrepo.Changes
+		err      error
+	)
+	if f.base != "" {
+		changes, err = gitrepo.Diff(target, f.base, f.ref)
+		if err != nil {
+			return nil, err
+		}
+		if changes.Head.FS.Len() == 0 {
+			// 변경된 Terraform 모듈이 없으면 스캔할 대상도 없음
+			return []*types.ScanResult{}, nil
+		}
+		snapshot = changes.Head
+	} else {
+		snapshot, err = gitrepo.Open(target, f.ref)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := tfScanner.ScanFS(context.Background(), snapshot.FS, filepath.Base(filepath.Clean(target)), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := snapshot.Metadata()
+	if changes != nil {
+		metadata = changes.Metadata()
+		if f.diffOnly {
+			scanner.FilterChangedLines(results, changes.Lines)
+		}
+	}
+	for _, result := range results {
+		result.Metadata = metadata
+	}
+	return results, nil
+}
+
+// renderOptions는 리포트 렌더링 옵션을 구성합니다
+func renderOptions(tfScanner *scanner.TerraformScanner, format, target string, f *scanFlags) (report.Options, error) {
+	policies := make(map[string]*types.PolicyMetadata)
+	for _, meta := range tfScanner.GetPolicies() {
+		policies[meta.ID] = meta
+	}
+	opts := report.Options{
+		Policies:     policies,
+		ArtifactName: filepath.Base(target),
+		Color:        !f.noColor && f.output == "" && isTerminal(os.Stdout),
+	}
+
+	if format == report.FormatTemplate {
+		text, err := loadTemplate(f.template, f.templateDir)
+		if err != nil {
+			return report.Options{}, err
+		}
+		if _, err := report.ParseTemplate(text); err != nil {
+			return report.Options{}, err
+		}
+		opts.Template = text
+	}
+
+	return opts, nil
+}
+
+// loadTemplate은 템플릿 값을 실제 템플릿 내용으로 변환합니다
+// "@경로"가 파일이면 파일 내용을, 그 외에는 이름 있는 템플릿 또는 값 자체를 사용합니다
+func loadTemplate(value, templateDir string) (string, error) {
+	if value == "" {
+		return "", errors.New("template is required for format template")
+	}
+
+	if path := strings.TrimPrefix(value, "@"); path != value {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return "", fmt.Errorf("failed to read template: %w", err)
+			}
+			return string(content), nil
+		}
+
+		if err := report.LoadTemplates(templateDir); err != nil {
+			return "", fmt.Errorf("failed to load templates: %w", err)
+		}
+	}
+
+	return report.ResolveTemplate(value)
+}
+
+// writeReport는 리포트를 파일 또는 표준 출력에 씁니다
+func writeReport(format string, results []*types.ScanResult, opts report.Options, output string) error {
+	renderer, _ := report.Get(format)
+
+	if output == "" {
+		return renderer.Render(os.Stdout, results, opts)
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	if err := renderer.Render(file, results, opts); err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+	return file.Close()
+}
+
+// hasFindings는 지정한 심각도 이상의 실패 항목이 있는지 확인합니다
+// 기준선에 포함된 항목은 제외됩니다
+func hasFindings(results []*types.ScanResult, severity string) bool {
+	minRank := types.SeverityRank(severity)
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			for _, misconfig := range result.Misconfigurations {
+				if misconfig.Status != "PASS" && types.SeverityRank(misconfig.Severity) >= minRank {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isTerminal은 파일이 터미널인지 확인합니다
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
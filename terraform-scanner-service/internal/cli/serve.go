@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"terraform-scanner-service/internal/api"
+	"terraform-scanner-service/internal/compliance"
+	"terraform-scanner-service/internal/config"
+	"terraform-scanner-service/internal/jobs"
+	"terraform-scanner-service/internal/report"
+	"terraform-scanner-service/internal/scanner"
+	"terraform-scanner-service/internal/store"
+
+	"github.com/gin-gonic/gin"
+)
+
+// runServe는 HTTP API 서버를 시작하고 종료 신호를 기다립니다
+func runServe(args []string) int {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := flags.String("addr", envOr("ADDR", ":8080"), "listen address")
+	policyDir := flags.String("policy-dir", envOr("POLICY_DIR", "../trivy-checks-source/checks"), "policy directory")
+	configFile := flags.String("config", envOr("CONFIG_FILE", "config/scanner.yaml"), "config file")
+	templateDir := flags.String("template-dir", envOr("TEMPLATE_DIR", "templates"), "named output template directory")
+	complianceDir := flags.String("compliance-dir", envOr("COMPLIANCE_DIR", "compliance"), "compliance spec directory")
+	scanRoots := flags.String("scan-roots", os.Getenv("SCAN_ROOTS"), "comma-separated directories that JSON scan targets must be inside (overrides config)")
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if err := serve(*addr, *policyDir, *configFile, *templateDir, *complianceDir, splitList(*scanRoots)); err != nil {
+		log.Printf("Server error: %v", err)
+		return exitError
+	}
+	return exitOK
+}
+
+// serve는 스캐너와 설정을 초기화하고 API 서버를 실행합니다
+// scanRoots가 지정되면 설정 파일의 server.scan_roots 대신 사용합니다
+func serve(addr, policyDir, configFile, templateDir, complianceDir string, scanRoots []string) error {
+	// Scanner 초기화
+	log.Println("Initializing Terraform scanner...")
+	tfScanner, err := scanner.NewTerraformScanner(policyDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize scanner: %w", err)
+	}
+	log.Printf("Scanner initialized with %d policies\n", tfScanner.PolicyCount())
+
+	// 설정 파일 로드 (프로파일 등)
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	log.Printf("Loaded %d profiles from %s\n", len(cfg.Profiles), configFile)
+
+	// 서버 경로 스캔 (JSON target) 허용 디렉토리
+	if len(scanRoots) > 0 {
+		cfg.Server.ScanRoots = scanRoots
+	}
+	log.Printf("Scan targets restricted to %s\n", strings.Join(cfg.Server.ScanRoots, ", "))
+
+	// 이름 있는 출력 템플릿 로드 (format=template&template=@이름)
+	if err := report.LoadTemplates(templateDir); err != nil {
+		return fmt.Errorf("failed to load templates: %w", err)
+	}
+	log.Printf("Loaded %d output templates from %s\n", len(report.TemplateNames()), templateDir)
+
+	// 컴플라이언스 스펙 로드
+	specs, err := compliance.LoadSpecs(complianceDir)
+	if err != nil {
+		return fmt.Errorf("failed to load compliance specs: %w", err)
+	}
+	log.Printf("Loaded %d compliance specs from %s\n", len(specs), complianceDir)
+
+	// 스캔 기록 저장소
+	resultStore, err := store.Open(cfg.Storage.Path)
+	if err != nil {
+		return fmt.Errorf("failed to open result store: %w", err)
+	}
+	defer resultStore.Close()
+	log.Printf("Scan history stored in %s\n", cfg.Storage.Path)
+
+	// 이 프로세스의 업로드 작업 디렉토리 (요청별 작업 디렉토리를 만들고 서버 종료 시 삭제)
+	if err := os.MkdirAll(cfg.Uploads.Dir, 0700); err != nil {
+		return fmt.Errorf("failed to create upload directory: %w", err)
+	}
+	uploadDir, err := os.MkdirTemp(cfg.Uploads.Dir, "server-")
+	if err != nil {
+		return fmt.Errorf("failed to create upload directory: %w", err)
+	}
+	defer os.RemoveAll(uploadDir)
+	log.Printf("Upload workspaces in %s\n", uploadDir)
+
+	// 파일별 스캔 결과 캐시
+	if !cfg.Cache.Disabled {
+		tfScanner.EnableCache(cfg.Cache.MaxEntries)
+		log.Printf("Scan result cache enabled (max %d entries)\n", cfg.Cache.MaxEntries)
+	}
+
+	// 비동기 스캔 작업 대기열
+	queue := jobs.NewQueue(cfg.Jobs.Concurrency, cfg.Jobs.QueueSize, cfg.Jobs.Timeout, cfg.Jobs.Retention)
+	log.Printf("Scan job queue started with %d workers\n", cfg.Jobs.Concurrency)
+
+	// Gin 라우터 설정
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.Default()
+
+	// API 핸들러 등록
+	handler := api.NewHandler(tfScanner, cfg, specs, queue, resultStore, uploadDir)
+	router.POST("/scan", handler.ScanTerraform)
+	router.GET("/health", handler.HealthCheck)
+	router.GET("/policies", handler.ListPolicies)
+	router.POST("/policies/reload", handler.ReloadPolicies)
+	router.GET("/profiles", handler.ListProfiles)
+	router.GET("/compliance", handler.ListCompliance)
+	router.POST("/scans", handler.SubmitScan)
+	router.GET("/scans/:id", handler.GetScan)
+	router.GET("/scans/:id/result", handler.GetScanResult)
+	router.DELETE("/scans/:id", handler.CancelScan)
+	router.GET("/results", handler.ListResults)
+	router.GET("/results/compare", handler.CompareResults)
+	router.GET("/results/:id", handler.GetResult)
+	router.GET("/results/:id/baseline", handler.GetBaseline)
+	router.GET("/results/:id/report.html", handler.GetHTMLReport)
+
+	// HTTP 서버 설정 (동기 스캔 시간보다 응답 제한 시간을 길게)
+	srv := &http.Server{
+		Addr:         addr,
+		Handler:      router,
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: cfg.Server.ScanTimeout + 10*time.Second,
+	}
+
+	// 서버 시작 (고루틴)
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Starting Terraform Scanner API on %s\n", addr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			serveErr <- err
+		}
+	}()
+
+	// Graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case <-quit:
+	case err := <-serveErr:
+		queue.Shutdown(context.Background())
+		return fmt.Errorf("failed to start server: %w", err)
+	}
+
+	log.Println("Shutting down server...")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("Server forced to shutdown: %v", err)
+	}
+
+	if err := queue.Shutdown(ctx); err != nil {
+		log.Printf("Scan jobs did not stop in time: %v", err)
+	}
+
+	log.Println("Server exited")
+	return nil
+}
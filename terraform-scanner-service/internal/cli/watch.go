@@ -0,0 +1,354 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"terraform-scanner-service/internal/scanner"
+	"terraform-scanner-service/internal/types"
+)
+
+// watcher는 감시 중인 디렉토리와 모듈별 최근 스캔 결과입니다
+type watcher struct {
+	dir     string
+	fsys    fs.FS
+	name    string // 루트 모듈 이름
+	session *scanSession
+	notify  *fsnotify.Watcher
+	results map[string]*types.ScanResult // 모듈 경로 -> 최근 스캔 결과
+	out     io.Writer
+}
+
+// watch는 디렉토리를 감시하며 변경된 모듈만 다시 스캔하고, 새로 발견되거나 해결된 문제를 출력합니다
+// 처음에는 전체 스캔 리포트를 출력하며, 인터럽트 (Ctrl+C) 를 받으면 종료합니다
+func watch(target string, f *scanFlags) error {
+	if f.ref != "" || f.base != "" {
+		return errors.New("--watch cannot be used with --ref or --base")
+	}
+	if f.debounce <= 0 {
+		return errors.New("--debounce must be positive")
+	}
+
+	info, err := os.Stat(target)
+	if err != nil {
+		return fmt.Errorf("target not found: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("--watch requires a directory: %s", target)
+	}
+
+	s, err := newScanSession(target, f)
+	if err != nil {
+		return err
+	}
+
+	notify, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start watcher: %w", err)
+	}
+	defer notify.Close()
+
+	w := &watcher{
+		dir:     target,
+		fsys:    os.DirFS(target),
+		name:    filepath.Base(filepath.Clean(target)),
+		session: s,
+		notify:  notify,
+		results: make(map[string]*types.ScanResult),
+		out:     os.Stdout,
+	}
+	if _, err := w.addDir("."); err != nil {
+		return err
+	}
+
+	// 파싱에 실패한 파일은 로그로만 보고되므로 감시 중에는 -v 없이도 출력
+	log.SetOutput(os.Stderr)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 처음 전체 스캔 (아직 Terraform 파일이 없어도 감시는 계속)
+	results, err := s.scanner.ScanFS(ctx, w.fsys, w.name, s.opts)
+	switch {
+	case ctx.Err() != nil:
+		return nil
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	default:
+		w.record(nil, results)
+		if err := writeReport(s.format, results, s.render, f.output); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Watching %s for changes (press Ctrl+C to stop)\n", target)
+
+	pending := make(map[string]bool)
+	timer := time.NewTimer(f.debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-notify.Events:
+			if !ok {
+				return nil
+			}
+			modules := w.affectedModules(event)
+			for _, m := range modules {
+				pending[m] = true
+			}
+			// 마지막 변경 후 debounce 동안 추가 변경이 없으면 한 번에 다시 스캔
+			if len(modules) > 0 {
+				// 이미 만료된 타이머의 값을 비워야 Reset 직후 이전 만료로 바로 스캔하지 않음
+				if !timer.Stop() {
+					select {
+					case <-timer.C:
+					default:
+					}
+				}
+				timer.Reset(f.debounce)
+			}
+
+		case err, ok := <-notify.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "Warning: watch error: %v\n", err)
+
+		case <-timer.C:
+			modules := sortedKeys(pending)
+			pending = make(map[string]bool)
+
+			if err := w.rescan(ctx, modules); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+		}
+	}
+}
+
+// addDir은 디렉토리와 하위 디렉토리 (건너뛸 디렉토리 제외) 를 감시 대상에 추가하고,
+// 그 안에서 Terraform 파일이 있는 모듈의 경로를 반환합니다
+func (w *watcher) addDir(rel string) ([]string, error) {
+	modules := make(map[string]bool)
+
+	err := fs.WalkDir(w.fsys, rel, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if w.session.opts.SkipsDir(p) {
+				return fs.SkipDir
+			}
+			if err := w.notify.Add(filepath.Join(w.dir, filepath.FromSlash(p))); err != nil {
+				return fmt.Errorf("failed to watch %s: %w", p, err)
+			}
+			return nil
+		}
+
+		if entry.Type().IsRegular() && w.session.opts.IncludesFile(p) {
+			modules[path.Dir(p)] = true
+		}
+		return nil
+	})
+
+	return sortedKeys(modules), err
+}
+
+// affectedModules는 파일 시스템 이벤트로 다시 스캔해야 하는 모듈의 경로를 반환합니다
+// 새 디렉토리는 감시 대상에 추가하고, 삭제되거나 이름이 바뀐 디렉토리 아래의 모듈도 포함합니다
+func (w *watcher) affectedModules(event fsnotify.Event) []string {
+	rel, err := filepath.Rel(w.dir, event.Name)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return nil
+	}
+	rel = filepath.ToSlash(rel)
+	opts := w.session.opts
+
+	if event.Has(fsnotify.Create) {
+		if info, err := os.Lstat(event.Name); err == nil && info.IsDir() {
+			if opts.SkipsDir(rel) {
+				return nil
+			}
+			modules, err := w.addDir(rel)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+			return modules
+		}
+	}
+
+	if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+		var modules []string
+		for m := range w.results {
+			if m == rel || strings.HasPrefix(m, rel+"/") {
+				modules = append(modules, m)
+			}
+		}
+		if opts.IncludesFile(rel) {
+			modules = append(modules, path.Dir(rel))
+		}
+		return modules
+	}
+
+	if (event.Has(fsnotify.Create) || event.Has(fsnotify.Write)) && opts.IncludesFile(rel) {
+		return []string{path.Dir(rel)}
+	}
+	return nil
+}
+
+// rescan은 모듈을 다시 스캔하고 이전 결과와 비교해 새로 발견되거나 해결된 문제를 출력합니다
+func (w *watcher) rescan(ctx context.Context, modules []string) error {
+	opts := w.session.opts
+	opts.Modules = modules
+
+	results, err := w.session.scanner.ScanFS(ctx, w.fsys, w.name, opts)
+	if err != nil {
+		return err
+	}
+	results = w.keepUnscanned(modules, results)
+
+	var before []*types.ScanResult
+	for _, m := range modules {
+		if result, ok := w.results[m]; ok {
+			before = append(before, result)
+		}
+	}
+
+	comparison := scanner.Compare(before, results)
+	w.record(modules, results)
+	w.printChanges(modules, comparison)
+	return nil
+}
+
+// keepUnscanned는 아직 존재하지만 스캔에 실패한 파일 (편집 중 문법 오류 등) 의
+// 이전 결과를 유지해, 파일을 고치는 동안 기존 문제가 해결된 것으로 보고되지 않게 합니다
+func (w *watcher) keepUnscanned(modules []string, results []*types.ScanResult) []*types.ScanResult {
+	byModule := make(map[string]*types.ScanResult)
+	scanned := make(map[string]bool)
+	for _, result := range results {
+		byModule[moduleOf(result)] = result
+		for _, r := range result.Results {
+			scanned[r.Target] = true
+		}
+	}
+
+	for _, m := range modules {
+		previous, ok := w.results[m]
+		if !ok {
+			continue
+		}
+
+		for _, r := range previous.Results {
+			if scanned[r.Target] {
+				continue
+			}
+			info, err := fs.Stat(w.fsys, r.Target)
+			if err != nil || !info.Mode().IsRegular() || !w.session.opts.IncludesFile(r.Target) {
+				continue
+			}
+
+			fmt.Fprintf(os.Stderr, "Warning: failed to scan %s, keeping previous findings\n", r.Target)
+			current, ok := byModule[m]
+			if !ok {
+				copied := *previous
+				copied.Results = nil
+				current = &copied
+				byModule[m] = current
+				results = append(results, current)
+			}
+			current.Results = append(current.Results, r)
+		}
+	}
+
+	return results
+}
+
+// record는 다시 스캔한 모듈의 이전 결과를 새 결과로 교체합니다
+func (w *watcher) record(modules []string, results []*types.ScanResult) {
+	for _, m := range modules {
+		delete(w.results, m)
+	}
+	for _, result := range results {
+		w.results[moduleOf(result)] = result
+	}
+}
+
+// printChanges는 다시 스캔한 모듈과 새로 발견되거나 해결된 문제를 출력합니다
+func (w *watcher) printChanges(modules []string, comparison *types.Comparison) {
+	names := make([]string, len(modules))
+	for i, m := range modules {
+		names[i] = m
+		if m == "." {
+			names[i] = w.name
+		}
+	}
+
+	total := 0
+	for _, result := range w.results {
+		for _, r := range result.Results {
+			if r.MisconfSummary != nil {
+				total += r.MisconfSummary.Failures
+			}
+		}
+	}
+
+	fmt.Fprintf(w.out, "[%s] rescanned %s: %d new, %d resolved (%d findings total)\n",
+		time.Now().Format("15:04:05"), strings.Join(names, ", "),
+		comparison.Summary.New, comparison.Summary.Resolved, total)
+	for _, finding := range comparison.New {
+		fmt.Fprintf(w.out, "  + %s\n", formatFinding(finding))
+	}
+	for _, finding := range comparison.Resolved {
+		fmt.Fprintf(w.out, "  - %s\n", formatFinding(finding))
+	}
+}
+
+// formatFinding은 문제를 한 줄로 표시합니다 (심각도, ID, 위치, 메시지)
+func formatFinding(finding types.Finding) string {
+	location := finding.Target
+	if cause := finding.CauseMetadata; cause != nil && cause.StartLine > 0 {
+		location = fmt.Sprintf("%s:%d", location, cause.StartLine)
+		if cause.EndLine > cause.StartLine {
+			location = fmt.Sprintf("%s-%d", location, cause.EndLine)
+		}
+	}
+	return fmt.Sprintf("%s %s %s %s", finding.Severity, finding.ID, location, finding.Message)
+}
+
+// moduleOf는 스캔 결과의 모듈 경로입니다 (모듈의 결과는 같은 디렉토리의 파일)
+func moduleOf(result *types.ScanResult) string {
+	if len(result.Results) == 0 {
+		return "."
+	}
+	return path.Dir(result.Results[0].Target)
+}
+
+// sortedKeys는 집합의 키를 정렬해 반환합니다
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,163 @@
+package compliance
+
+import (
+	"strings"
+	"time"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// 통제 항목 상태
+const (
+	StatusPass    = "PASS"
+	StatusFail    = "FAIL"
+	StatusManual  = "MANUAL"
+	StatusUnknown = "UNKNOWN"
+)
+
+// Report는 컴플라이언스 스펙 기준의 스캔 리포트입니다
+type Report struct {
+	ID               string           `json:"ID"`
+	Title            string           `json:"Title"`
+	Description      string           `json:"Description"`
+	Version          string           `json:"Version"`
+	RelatedResources []string         `json:"RelatedResources,omitempty"`
+	ArtifactName     string           `json:"ArtifactName"`
+	CreatedAt        time.Time        `json:"CreatedAt"`
+	Summary          Summary          `json:"Summary"`
+	Results          []*ControlResult `json:"Results"`
+}
+
+// Summary는 통제 항목 상태별 개수입니다
+type Summary struct {
+	TotalControls   int `json:"TotalControls"`
+	PassedControls  int `json:"PassedControls"`
+	FailedControls  int `json:"FailedControls"`
+	ManualControls  int `json:"ManualControls"`
+	UnknownControls int `json:"UnknownControls"`
+}
+
+// ControlResult는 통제 항목별 점검 결과입니다
+type ControlResult struct {
+	ID          string         `json:"ID"`
+	Name        string         `json:"Name"`
+	Description string         `json:"Description,omitempty"`
+	Severity    string         `json:"Severity"`
+	Checks      []string       `json:"Checks,omitempty"`
+	Status      string         `json:"Status"`
+	TotalPass   int            `json:"TotalPass"`
+	TotalFail   int            `json:"TotalFail"`
+	Results     []types.Result `json:"Results,omitempty"`
+}
+
+// BuildReport는 스캔 결과를 스펙의 통제 항목별로 집계합니다
+// 스캔 결과에 PASS 항목이 포함되어 있어야 통과 수가 집계됩니다
+func BuildReport(spec *Spec, artifactName string, results []*types.ScanResult) *Report {
+	report := &Report{
+		ID:               spec.Spec.ID,
+		Title:            spec.Spec.Title,
+		Description:      spec.Spec.Description,
+		Version:          spec.Spec.Version,
+		RelatedResources: spec.Spec.RelatedResources,
+		ArtifactName:     artifactName,
+		CreatedAt:        time.Now(),
+	}
+
+	for _, control := range spec.Spec.Controls {
+		controlResult := buildControlResult(control, results)
+		report.Results = append(report.Results, controlResult)
+
+		report.Summary.TotalControls++
+		switch controlResult.Status {
+		case StatusPass:
+			report.Summary.PassedControls++
+		case StatusFail:
+			report.Summary.FailedControls++
+		case StatusManual:
+			report.Summary.ManualControls++
+		default:
+			report.Summary.UnknownControls++
+		}
+	}
+
+	return report
+}
+
+// buildControlResult는 단일 통제 항목의 결과를 집계합니다
+func buildControlResult(control Control, results []*types.ScanResult) *ControlResult {
+	controlResult := &ControlResult{
+		ID:          control.ID,
+		Name:        control.Name,
+		Description: control.Description,
+		Severity:    control.Severity,
+	}
+
+	checkIDs := make(map[string]bool)
+	for _, check := range control.Checks {
+		checkIDs[strings.ToUpper(check.ID)] = true
+		controlResult.Checks = append(controlResult.Checks, check.ID)
+	}
+
+	matches := func(misconfig types.Misconfiguration) bool {
+		return checkIDs[strings.ToUpper(misconfig.ID)] || checkIDs[strings.ToUpper(misconfig.AVDID)]
+	}
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			var failed, baselined []types.Misconfiguration
+
+			for _, misconfig := range result.Misconfigurations {
+				if !matches(misconfig) {
+					continue
+				}
+
+				if misconfig.Status == StatusPass {
+					controlResult.TotalPass++
+					continue
+				}
+				controlResult.TotalFail++
+				failed = append(failed, misconfig)
+			}
+
+			// 기준선으로 분리된 항목도 해결된 것은 아니므로 실패로 집계
+			for _, misconfig := range result.Baselined {
+				if matches(misconfig) {
+					controlResult.TotalFail++
+					baselined = append(baselined, misconfig)
+				}
+			}
+
+			if len(failed) > 0 || len(baselined) > 0 {
+				controlResult.Results = append(controlResult.Results, types.Result{
+					Target:            result.Target,
+					Class:             result.Class,
+					Type:              result.Type,
+					Misconfigurations: failed,
+					Baselined:         baselined,
+				})
+			}
+		}
+	}
+
+	controlResult.Status = controlStatus(control, controlResult)
+	return controlResult
+}
+
+// controlStatus는 통제 항목의 상태를 결정합니다
+func controlStatus(control Control, result *ControlResult) string {
+	switch {
+	case len(control.Checks) == 0:
+		// 자동 점검이 없는 항목은 수동 확인 대상
+		if control.DefaultStatus != "" {
+			return control.DefaultStatus
+		}
+		return StatusManual
+	case result.TotalFail > 0:
+		return StatusFail
+	case result.TotalPass > 0:
+		return StatusPass
+	default:
+		// 연결된 정책이 로드되지 않았거나 평가되지 않음
+		return StatusUnknown
+	}
+}
@@ -0,0 +1,73 @@
+package compliance
+
+import (
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestBuildControlResult(t *testing.T) {
+	pass := types.Misconfiguration{ID: "TEST-001", Status: StatusPass}
+	fail := types.Misconfiguration{ID: "TEST-001", Status: StatusFail}
+	other := types.Misconfiguration{ID: "TEST-999", Status: StatusFail}
+
+	tests := []struct {
+		name      string
+		control   Control
+		result    types.Result
+		status    string
+		totalPass int
+		totalFail int
+	}{
+		{
+			name:      "passed",
+			control:   Control{Checks: []SpecCheck{{ID: "test-001"}}},
+			result:    types.Result{Misconfigurations: []types.Misconfiguration{pass, other}},
+			status:    StatusPass,
+			totalPass: 1,
+		},
+		{
+			name:      "failed",
+			control:   Control{Checks: []SpecCheck{{ID: "TEST-001"}}},
+			result:    types.Result{Misconfigurations: []types.Misconfiguration{pass, fail}},
+			status:    StatusFail,
+			totalPass: 1,
+			totalFail: 1,
+		},
+		{
+			name:      "only baselined failures",
+			control:   Control{Checks: []SpecCheck{{ID: "TEST-001"}}},
+			result:    types.Result{Misconfigurations: []types.Misconfiguration{pass}, Baselined: []types.Misconfiguration{fail}},
+			status:    StatusFail,
+			totalPass: 1,
+			totalFail: 1,
+		},
+		{
+			name:    "baselined failures of other checks",
+			control: Control{Checks: []SpecCheck{{ID: "TEST-001"}}},
+			result:  types.Result{Baselined: []types.Misconfiguration{other}},
+			status:  StatusUnknown,
+		},
+		{
+			name:    "manual",
+			control: Control{},
+			result:  types.Result{Misconfigurations: []types.Misconfiguration{fail}},
+			status:  StatusManual,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.result.Target = "main.tf"
+			got := buildControlResult(tt.control, []*types.ScanResult{{Results: []types.Result{tt.result}}})
+
+			if got.Status != tt.status || got.TotalPass != tt.totalPass || got.TotalFail != tt.totalFail {
+				t.Errorf("buildControlResult() = %s (pass %d, fail %d), want %s (pass %d, fail %d)",
+					got.Status, got.TotalPass, got.TotalFail, tt.status, tt.totalPass, tt.totalFail)
+			}
+			if wantResults := tt.totalFail > 0; (len(got.Results) > 0) != wantResults {
+				t.Errorf("buildControlResult() results = %v, want failed targets %v", got.Results, wantResults)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package compliance
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Trivy 호환 컴플라이언스 스펙 구조
+
+// Spec은 컴플라이언스 스펙 파일입니다
+type Spec struct {
+	Spec ComplianceSpec `yaml:"spec" json:"spec"`
+}
+
+// ComplianceSpec은 컴플라이언스 프레임워크 정의입니다
+type ComplianceSpec struct {
+	ID               string    `yaml:"id" json:"id"`
+	Title            string    `yaml:"title" json:"title"`
+	Description      string    `yaml:"description" json:"description"`
+	Version          string    `yaml:"version" json:"version"`
+	Platform         string    `yaml:"platform" json:"platform,omitempty"`
+	Type             string    `yaml:"type" json:"type,omitempty"`
+	RelatedResources []string  `yaml:"relatedResources" json:"relatedResources,omitempty"`
+	Controls         []Control `yaml:"controls" json:"controls"`
+}
+
+// Control은 컴플라이언스 통제 항목입니다
+type Control struct {
+	ID            string        `yaml:"id" json:"id"`
+	Name          string        `yaml:"name" json:"name"`
+	Description   string        `yaml:"description" json:"description,omitempty"`
+	Checks        []SpecCheck   `yaml:"checks" json:"checks,omitempty"`
+	Severity      string        `yaml:"severity" json:"severity"`
+	DefaultStatus string        `yaml:"defaultStatus" json:"defaultStatus,omitempty"`
+	Commands      []SpecCommand `yaml:"commands,omitempty" json:"-"`
+}
+
+// SpecCheck는 통제 항목에 연결된 정책입니다
+type SpecCheck struct {
+	ID string `yaml:"id" json:"id"`
+}
+
+// SpecCommand는 수동 점검 명령입니다 (Trivy 호환을 위해 파싱만 함)
+type SpecCommand struct {
+	ID string `yaml:"id" json:"id"`
+}
+
+// LoadSpecs는 디렉토리에서 컴플라이언스 스펙 (.yaml, .yml) 을 로드합니다
+// 디렉토리가 없으면 빈 목록을 반환합니다
+func LoadSpecs(dir string) (map[string]*Spec, error) {
+	specs := make(map[string]*Spec)
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return specs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compliance directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		spec, err := LoadSpec(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, exists := specs[spec.Spec.ID]; exists {
+			return nil, fmt.Errorf("duplicate compliance spec: %s", spec.Spec.ID)
+		}
+		specs[spec.Spec.ID] = spec
+	}
+
+	return specs, nil
+}
+
+// LoadSpec은 단일 컴플라이언스 스펙 파일을 로드합니다
+func LoadSpec(path string) (*Spec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	spec := &Spec{}
+	if err := yaml.Unmarshal(content, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if spec.Spec.ID == "" {
+		return nil, fmt.Errorf("compliance spec id is required: %s", path)
+	}
+
+	for i := range spec.Spec.Controls {
+		control := &spec.Spec.Controls[i]
+		control.Severity = strings.ToUpper(control.Severity)
+		control.DefaultStatus = strings.ToUpper(control.DefaultStatus)
+	}
+
+	return spec, nil
+}
+
+// CheckIDs는 스펙에 포함된 모든 정책 ID를 반환합니다
+func (s *Spec) CheckIDs() []string {
+	seen := make(map[string]bool)
+	var ids []string
+
+	for _, control := range s.Spec.Controls {
+		for _, check := range control.Checks {
+			if check.ID != "" && !seen[check.ID] {
+				seen[check.ID] = true
+				ids = append(ids, check.ID)
+			}
+		}
+	}
+
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,287 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"terraform-scanner-service/internal/scanner"
+	"terraform-scanner-service/internal/types"
+	"terraform-scanner-service/internal/upload"
+)
+
+// Config는 서버 설정 파일 구조입니다
+type Config struct {
+	Server   ServerConfig   `yaml:"server"`
+	Jobs     JobsConfig     `yaml:"jobs"`
+	Storage  StorageConfig  `yaml:"storage"`
+	Uploads  UploadsConfig  `yaml:"uploads"`
+	Cache    CacheConfig    `yaml:"cache"`
+	Profiles []*Profile     `yaml:"profiles"`
+	Severity SeverityConfig `yaml:"severity"`
+}
+
+// ServerConfig는 HTTP 서버 설정입니다
+type ServerConfig struct {
+	// ScanTimeout은 동기 스캔 (POST /scan) 의 최대 실행 시간입니다
+	ScanTimeout time.Duration `yaml:"scan_timeout"`
+
+	// ScanRoots는 JSON 요청의 target으로 스캔할 수 있는 디렉토리입니다 (기본값: 작업 디렉토리)
+	ScanRoots []string `yaml:"scan_roots"`
+}
+
+// StorageConfig는 스캔 기록 저장소 설정입니다
+type StorageConfig struct {
+	// Path는 스캔 기록 데이터베이스 파일 경로입니다
+	Path string `yaml:"path"`
+}
+
+// UploadsConfig는 multipart 업로드 (파일, 압축 파일) 제한입니다
+type UploadsConfig struct {
+	// Dir은 업로드 작업 디렉토리를 만드는 경로입니다
+	// 서버 프로세스마다 하위 디렉토리를 만들고 서버 종료 시 삭제합니다
+	Dir string `yaml:"dir"`
+
+	// MaxSizeMB는 요청당 압축 해제 후 전체 파일 크기 제한입니다 (MB)
+	MaxSizeMB int64 `yaml:"max_size_mb"`
+
+	// MaxEntries는 요청당 최대 항목 수입니다 (압축 파일은 디렉토리 항목 포함)
+	MaxEntries int `yaml:"max_entries"`
+}
+
+// Limits는 업로드 작업 디렉토리의 제한 값을 반환합니다
+func (u UploadsConfig) Limits() upload.Limits {
+	return upload.Limits{
+		MaxBytes:   u.MaxSizeMB << 20,
+		MaxEntries: u.MaxEntries,
+	}
+}
+
+// CacheConfig는 파일별 스캔 결과 캐시 설정입니다
+type CacheConfig struct {
+	// Disabled는 결과 캐시를 사용하지 않을지 여부입니다
+	Disabled bool `yaml:"disabled"`
+
+	// MaxEntries는 캐시에 보관하는 최대 파일 결과 수입니다
+	MaxEntries int `yaml:"max_entries"`
+}
+
+// JobsConfig는 비동기 스캔 작업 대기열 설정입니다
+type JobsConfig struct {
+	// Concurrency는 동시에 실행되는 작업 수입니다
+	Concurrency int `yaml:"concurrency"`
+
+	// QueueSize는 대기 가능한 최대 작업 수입니다
+	QueueSize int `yaml:"queue_size"`
+
+	// Timeout은 작업별 최대 실행 시간입니다
+	Timeout time.Duration `yaml:"timeout"`
+
+	// Retention은 종료된 작업 결과의 보관 기간입니다
+	Retention time.Duration `yaml:"retention"`
+}
+
+// SeverityConfig는 전역 심각도 매핑 설정입니다
+type SeverityConfig struct {
+	// Default는 심각도가 정의되지 않은 정책에 사용할 값입니다
+	Default string `yaml:"default"`
+
+	// Overrides는 정책 ID (glob) 별 심각도 재정의 규칙입니다
+	Overrides []types.SeverityOverride `yaml:"overrides"`
+}
+
+// Profile은 서버에 정의된 이름 있는 정책 선택 묶음입니다
+type Profile struct {
+	Name              string             `yaml:"name" json:"name"`
+	Description       string             `yaml:"description,omitempty" json:"description,omitempty"`
+	Filter            types.PolicyFilter `yaml:"filter,omitempty" json:"filter"`
+	SeverityOverrides map[string]string  `yaml:"severity_overrides,omitempty" json:"severity_overrides,omitempty"`
+	Ignore            []types.IgnoreRule `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+}
+
+// Load는 설정 파일을 로드합니다
+// 파일이 없으면 빈 설정을 반환합니다
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		cfg.applyDefaults()
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	cfg.applyDefaults()
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// applyDefaults는 지정되지 않은 설정에 기본값을 채웁니다
+func (c *Config) applyDefaults() {
+	if c.Server.ScanTimeout <= 0 {
+		c.Server.ScanTimeout = 30 * time.Second
+	}
+	if len(c.Server.ScanRoots) == 0 {
+		c.Server.ScanRoots = []string{"."}
+	}
+	if c.Jobs.Concurrency <= 0 {
+		c.Jobs.Concurrency = 2
+	}
+	if c.Jobs.QueueSize <= 0 {
+		c.Jobs.QueueSize = 100
+	}
+	if c.Jobs.Timeout <= 0 {
+		c.Jobs.Timeout = 30 * time.Minute
+	}
+	if c.Jobs.Retention <= 0 {
+		c.Jobs.Retention = 24 * time.Hour
+	}
+	if c.Storage.Path == "" {
+		c.Storage.Path = "scan-results/scans.db"
+	}
+	if c.Uploads.Dir == "" {
+		c.Uploads.Dir = filepath.Join(os.TempDir(), "terraform-scanner-uploads")
+	}
+	if c.Uploads.MaxSizeMB <= 0 {
+		c.Uploads.MaxSizeMB = 50
+	}
+	if c.Uploads.MaxEntries <= 0 {
+		c.Uploads.MaxEntries = 1000
+	}
+	if c.Cache.MaxEntries <= 0 {
+		c.Cache.MaxEntries = 10000
+	}
+}
+
+// validate는 설정 값을 검증하고 정규화합니다
+func (c *Config) validate() error {
+	if c.Severity.Default != "" {
+		if !types.IsValidSeverity(c.Severity.Default) {
+			return fmt.Errorf("invalid default severity: %s", c.Severity.Default)
+		}
+		c.Severity.Default = strings.ToUpper(c.Severity.Default)
+	}
+
+	if err := scanner.ValidateSeverityOverrides(c.Severity.Overrides); err != nil {
+		return err
+	}
+
+	names := make(map[string]bool)
+
+	for _, profile := range c.Profiles {
+		if profile.Name == "" {
+			return fmt.Errorf("profile name is required")
+		}
+		if names[profile.Name] {
+			return fmt.Errorf("duplicate profile: %s", profile.Name)
+		}
+		names[profile.Name] = true
+
+		filter, err := scanner.NormalizeFilter(&profile.Filter)
+		if err != nil {
+			return fmt.Errorf("profile %s: %w", profile.Name, err)
+		}
+		if filter != nil {
+			profile.Filter = *filter
+		}
+
+		for id, severity := range profile.SeverityOverrides {
+			if !types.IsValidSeverity(severity) {
+				return fmt.Errorf("profile %s: invalid severity for %s: %s", profile.Name, id, severity)
+			}
+			profile.SeverityOverrides[id] = strings.ToUpper(severity)
+		}
+	}
+
+	return nil
+}
+
+// Profile은 이름으로 프로파일을 찾습니다
+func (c *Config) Profile(name string) (*Profile, bool) {
+	for _, profile := range c.Profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return nil, false
+}
+
+// ScanOptions는 요청 필터, 프로파일, 전역 설정으로 스캔 옵션을 구성합니다
+// 프로파일이 지정되면 프로파일 설정 위에 요청의 필터를 적용합니다
+func (c *Config) ScanOptions(filter *types.PolicyFilter, profileName, environment string) (scanner.ScanOptions, error) {
+	filter, err := scanner.NormalizeFilter(filter)
+	if err != nil {
+		return scanner.ScanOptions{}, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	opts := scanner.ScanOptions{
+		Filter:      filter,
+		Environment: environment,
+	}
+
+	if profileName != "" {
+		profile, ok := c.Profile(profileName)
+		if !ok {
+			return scanner.ScanOptions{}, fmt.Errorf("unknown profile: %s", profileName)
+		}
+		opts = profile.Apply(opts)
+	}
+
+	return c.Apply(opts), nil
+}
+
+// Apply는 전역 심각도 설정을 스캔 옵션에 적용합니다
+// 전역 재정의 규칙은 이미 지정된 (프로파일) 규칙 뒤에 추가됩니다
+func (c *Config) Apply(opts scanner.ScanOptions) scanner.ScanOptions {
+	opts.SeverityOverrides = append(append([]types.SeverityOverride{}, opts.SeverityOverrides...), c.Severity.Overrides...)
+	if opts.DefaultSeverity == "" {
+		opts.DefaultSeverity = c.Severity.Default
+	}
+	return opts
+}
+
+// Apply는 프로파일을 스캔 옵션에 적용합니다
+// 요청에 지정된 필터는 프로파일 필터 안에서만 적용되어 (두 조건을 모두 만족하는 정책만 평가)
+// 프로파일이 선택한 정책 범위를 넓힐 수 없습니다
+func (p *Profile) Apply(opts scanner.ScanOptions) scanner.ScanOptions {
+	filter := p.Filter
+	opts.Profile = p.Name
+	opts.Filter = opts.Filter.Narrow(&filter)
+	opts.SeverityOverrides = append(p.severityOverrides(), opts.SeverityOverrides...)
+	opts.Ignore = append(append([]types.IgnoreRule{}, p.Ignore...), opts.Ignore...)
+
+	return opts
+}
+
+// severityOverrides는 프로파일의 심각도 재정의를 규칙 목록으로 변환합니다
+func (p *Profile) severityOverrides() []types.SeverityOverride {
+	ids := make([]string, 0, len(p.SeverityOverrides))
+	for id := range p.SeverityOverrides {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	overrides := make([]types.SeverityOverride, 0, len(ids))
+	for _, id := range ids {
+		overrides = append(overrides, types.SeverityOverride{
+			ID:       id,
+			Severity: p.SeverityOverrides[id],
+		})
+	}
+	return overrides
+}
@@ -0,0 +1,170 @@
+package gitrepo
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	fdiff "github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// Changes는 두 ref 사이에서 변경된 Terraform 모듈입니다
+type Changes struct {
+	// Head는 head ref 시점에서 변경된 모듈의 파일만 담은 스냅샷입니다
+	Head *Snapshot
+
+	// BaseRef, Base는 비교 기준 ref와 커밋입니다
+	BaseRef string
+	Base    *object.Commit
+
+	// Modules는 변경된 .tf 파일이 속한 모듈 디렉토리입니다 (저장소 루트 기준, 루트는 ".")
+	Modules []string
+
+	// Lines는 head 기준 파일별로 추가/수정된 줄 범위입니다
+	// 줄이 삭제된 위치는 바로 다음 줄로 표시합니다
+	Lines map[string][]types.LineRange
+}
+
+// Diff는 base와 head ref 사이에서 변경된 Terraform 파일을 찾고,
+// 변경된 파일이 속한 모듈의 head 시점 파일만 읽습니다
+func Diff(repoPath, baseRef, headRef string) (*Changes, error) {
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository: %w", err)
+	}
+
+	if headRef == "" {
+		headRef = DefaultRef
+	}
+
+	base, err := resolveCommit(repo, baseRef)
+	if err != nil {
+		return nil, err
+	}
+	head, err := resolveCommit(repo, headRef)
+	if err != nil {
+		return nil, err
+	}
+
+	baseTree, err := base.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree: %w", err)
+	}
+	headTree, err := head.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree: %w", err)
+	}
+
+	treeChanges, err := object.DiffTree(baseTree, headTree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff trees: %w", err)
+	}
+
+	changes := &Changes{
+		BaseRef: baseRef,
+		Base:    base,
+		Lines:   make(map[string][]types.LineRange),
+	}
+	modules := make(map[string]bool)
+
+	for _, change := range treeChanges {
+		from, to := change.From.Name, change.To.Name
+		if !isTerraformFile(from) && !isTerraformFile(to) {
+			continue
+		}
+
+		// 삭제된 파일도 남은 모듈 구성에 영향을 주므로 해당 모듈을 스캔 대상에 포함
+		for _, name := range []string{from, to} {
+			if isTerraformFile(name) {
+				modules[path.Dir(name)] = true
+			}
+		}
+
+		if to == "" || !isTerraformFile(to) {
+			continue
+		}
+
+		lines, err := changedLines(change)
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff %s: %w", to, err)
+		}
+		changes.Lines[to] = lines
+	}
+
+	for module := range modules {
+		changes.Modules = append(changes.Modules, module)
+	}
+	sort.Strings(changes.Modules)
+
+	fsys, err := readTree(head, func(name string) bool {
+		return modules[path.Dir(name)]
+	})
+	if err != nil {
+		return nil, err
+	}
+	changes.Head = &Snapshot{FS: fsys, Ref: headRef, Commit: head}
+
+	return changes, nil
+}
+
+// Metadata는 스캔 결과에 기록할 head 커밋과 비교 기준 커밋 정보를 반환합니다
+func (c *Changes) Metadata() *types.Metadata {
+	metadata := c.Head.Metadata()
+	metadata.BaseRef = c.BaseRef
+	metadata.BaseCommit = c.Base.Hash.String()
+	return metadata
+}
+
+// changedLines는 변경 사항의 patch에서 head 기준 추가/수정된 줄 범위를 계산합니다
+func changedLines(change *object.Change) ([]types.LineRange, error) {
+	patch, err := change.Patch()
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges []types.LineRange
+	for _, filePatch := range patch.FilePatches() {
+		if filePatch.IsBinary() {
+			continue
+		}
+
+		line := 0 // 마지막으로 지나온 head 줄 번호
+		for _, chunk := range filePatch.Chunks() {
+			count := countLines(chunk.Content())
+
+			switch chunk.Type() {
+			case fdiff.Equal:
+				line += count
+			case fdiff.Add:
+				ranges = append(ranges, types.LineRange{Start: line + 1, End: line + count})
+				line += count
+			case fdiff.Delete:
+				// 삭제 위치의 앞뒤 줄을 변경된 것으로 간주 (예: 블록 속성 삭제)
+				start := line
+				if start < 1 {
+					start = 1
+				}
+				ranges = append(ranges, types.LineRange{Start: start, End: line + 1})
+			}
+		}
+	}
+
+	return ranges, nil
+}
+
+// countLines는 청크 내용의 줄 수를 계산합니다 (마지막 줄에 개행이 없어도 한 줄로 계산)
+func countLines(content string) int {
+	if content == "" {
+		return 0
+	}
+	count := strings.Count(content, "\n")
+	if !strings.HasSuffix(content, "\n") {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,165 @@
+package gitrepo
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// commitFiles는 작업 트리에 files를 쓰고 커밋합니다
+func commitFiles(t *testing.T, repo *git.Repository, dir string, files map[string]string) *object.Commit {
+	t.Helper()
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := worktree.Add(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hash, err := worktree.Commit("update", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return commit
+}
+
+// fileChange는 두 커밋 사이에서 name 파일의 변경 사항을 찾습니다
+func fileChange(t *testing.T, base, head *object.Commit, name string) *object.Change {
+	t.Helper()
+
+	baseTree, err := base.Tree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	headTree, err := head.Tree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, err := object.DiffTree(baseTree, headTree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range changes {
+		if change.To.Name == name {
+			return change
+		}
+	}
+	t.Fatalf("no change for %s", name)
+	return nil
+}
+
+func TestChangedLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string // 빈 문자열이면 head에서 새로 추가된 파일
+		after  string
+		want   []types.LineRange
+	}{
+		{
+			name:   "modified line",
+			before: "a\nb\nc\n",
+			after:  "a\nB\nc\n",
+			want:   []types.LineRange{{Start: 1, End: 2}, {Start: 2, End: 2}},
+		},
+		{
+			name:   "appended lines",
+			before: "a\n",
+			after:  "a\nb\nc\n",
+			want:   []types.LineRange{{Start: 2, End: 3}},
+		},
+		{
+			name:   "inserted lines",
+			before: "a\nd\n",
+			after:  "a\nb\nc\nd\n",
+			want:   []types.LineRange{{Start: 2, End: 3}},
+		},
+		{
+			name:   "deleted line",
+			before: "a\nb\nc\n",
+			after:  "a\nc\n",
+			want:   []types.LineRange{{Start: 1, End: 2}},
+		},
+		{
+			name:   "deleted first line",
+			before: "a\nb\n",
+			after:  "b\n",
+			want:   []types.LineRange{{Start: 1, End: 1}},
+		},
+		{
+			name:   "separate changes",
+			before: "a\nb\nc\nd\ne\n",
+			after:  "A\nb\nc\nd\ne\nf\n",
+			want:   []types.LineRange{{Start: 1, End: 1}, {Start: 1, End: 1}, {Start: 6, End: 6}},
+		},
+		{
+			name:  "new file",
+			after: "a\nb\n",
+			want:  []types.LineRange{{Start: 1, End: 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			repo, err := git.PlainInit(dir, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			initial := map[string]string{"other.tf": "# other\n"}
+			if tt.before != "" {
+				initial["main.tf"] = tt.before
+			}
+			base := commitFiles(t, repo, dir, initial)
+			head := commitFiles(t, repo, dir, map[string]string{"main.tf": tt.after})
+
+			got, err := changedLines(fileChange(t, base, head, "main.tf"))
+			if err != nil {
+				t.Fatalf("changedLines() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changedLines() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	tests := []struct {
+		content string
+		want    int
+	}{
+		{"", 0},
+		{"a", 1},
+		{"a\n", 1},
+		{"a\nb", 2},
+		{"a\nb\n", 2},
+		{"\n\n", 2},
+	}
+
+	for _, tt := range tests {
+		if got := countLines(tt.content); got != tt.want {
+			t.Errorf("countLines(%q) = %d, want %d", tt.content, got, tt.want)
+		}
+	}
+}
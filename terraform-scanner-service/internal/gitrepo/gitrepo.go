@@ -0,0 +1,132 @@
+package gitrepo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"terraform-scanner-service/internal/memfs"
+	"terraform-scanner-service/internal/types"
+)
+
+// DefaultRef는 ref를 지정하지 않았을 때 사용하는 값입니다
+const DefaultRef = "HEAD"
+
+// ErrRefNotFound는 ref를 커밋으로 해석할 수 없을 때 반환됩니다
+var ErrRefNotFound = errors.New("git ref not found")
+
+// Snapshot은 특정 커밋 시점의 Terraform 파일 트리입니다
+type Snapshot struct {
+	// FS는 커밋 트리의 .tf, .tfvars 파일을 담은 인메모리 파일 시스템입니다
+	FS *memfs.FS
+
+	// Ref는 요청한 ref (브랜치, 태그, 커밋 SHA 등) 입니다
+	Ref string
+
+	// Commit은 ref가 가리키는 커밋입니다
+	Commit *object.Commit
+}
+
+// Open은 로컬 git 저장소에서 ref의 트리를 읽어 스냅샷을 생성합니다
+// 작업 트리를 체크아웃하지 않고 git 객체에서 직접 읽습니다
+func Open(repoPath, ref string) (*Snapshot, error) {
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository: %w", err)
+	}
+
+	commit, err := resolveCommit(repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	fsys, err := readTree(commit, func(string) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	if ref == "" {
+		ref = DefaultRef
+	}
+	return &Snapshot{FS: fsys, Ref: ref, Commit: commit}, nil
+}
+
+// Metadata는 스캔 결과에 기록할 커밋 정보를 반환합니다
+func (s *Snapshot) Metadata() *types.Metadata {
+	return &types.Metadata{
+		Ref:       s.Ref,
+		Commit:    s.Commit.Hash.String(),
+		CommitMsg: strings.TrimSpace(s.Commit.Message),
+		Author:    s.Commit.Author.String(),
+		Committer: s.Commit.Committer.String(),
+	}
+}
+
+// resolveCommit은 ref를 커밋으로 해석합니다 (브랜치, 태그, SHA, HEAD~1 같은 표현식)
+func resolveCommit(repo *git.Repository, ref string) (*object.Commit, error) {
+	if ref == "" {
+		ref = DefaultRef
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRefNotFound, ref)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit %s: %w", hash, err)
+	}
+	return commit, nil
+}
+
+// readTree는 커밋 트리의 Terraform 파일 중 include가 true인 파일을 인메모리 파일 시스템으로 읽습니다
+// 심볼릭 링크와 서브모듈은 제외합니다
+func readTree(commit *object.Commit, include func(name string) bool) (*memfs.FS, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree: %w", err)
+	}
+
+	fsys := memfs.New(commit.Committer.When)
+	err = tree.Files().ForEach(func(file *object.File) error {
+		if file.Mode != filemode.Regular && file.Mode != filemode.Executable {
+			return nil
+		}
+		if !isTerraformFile(file.Name) || !include(file.Name) {
+			return nil
+		}
+
+		reader, err := file.Reader()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+		defer reader.Close()
+
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+
+		fsys.Add(file.Name, content)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fsys, nil
+}
+
+// isTerraformFile은 스캔 대상 확장자인지 확인합니다
+func isTerraformFile(name string) bool {
+	ext := path.Ext(name)
+	return ext == ".tf" || ext == ".tfvars"
+}
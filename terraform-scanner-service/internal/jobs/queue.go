@@ -0,0 +1,357 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status는 작업 상태입니다
+type Status string
+
+// 작업 상태
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+	StatusCanceled  Status = "canceled"
+)
+
+// ErrQueueFull은 대기열이 가득 찼을 때 반환됩니다
+var ErrQueueFull = errors.New("job queue is full")
+
+// ErrShuttingDown은 대기열이 종료된 후 작업을 추가하려 할 때 반환됩니다
+var ErrShuttingDown = errors.New("job queue is shutting down")
+
+// ErrJobFinished는 이미 종료된 작업을 취소하려 할 때 반환됩니다
+var ErrJobFinished = errors.New("job already finished")
+
+// ProgressFunc는 작업 진행 상황을 보고합니다
+type ProgressFunc func(done, total int)
+
+// Func는 대기열에서 실행되는 작업입니다
+type Func func(ctx context.Context, progress ProgressFunc) (interface{}, error)
+
+// Progress는 작업 진행 상황입니다
+type Progress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// Info는 작업 상태 조회 응답입니다
+type Info struct {
+	ID         string     `json:"id"`
+	Status     Status     `json:"status"`
+	Progress   Progress   `json:"progress"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// Job은 비동기 스캔 작업입니다
+type Job struct {
+	mu         sync.RWMutex
+	id         string
+	status     Status
+	progress   Progress
+	createdAt  time.Time
+	startedAt  *time.Time
+	finishedAt *time.Time
+	err        error
+	result     interface{}
+
+	run     Func
+	cleanup func()
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// ID는 작업 ID를 반환합니다
+func (j *Job) ID() string {
+	return j.id
+}
+
+// Info는 작업 상태의 스냅샷을 반환합니다
+func (j *Job) Info() Info {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	info := Info{
+		ID:         j.id,
+		Status:     j.status,
+		Progress:   j.progress,
+		CreatedAt:  j.createdAt,
+		StartedAt:  j.startedAt,
+		FinishedAt: j.finishedAt,
+	}
+	if j.err != nil {
+		info.Error = j.err.Error()
+	}
+	return info
+}
+
+// Result는 작업 결과와 상태를 반환합니다
+func (j *Job) Result() (interface{}, Status, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.result, j.status, j.err
+}
+
+// finished는 작업이 종료 상태인지 확인합니다
+func (j *Job) finished() bool {
+	switch j.status {
+	case StatusCompleted, StatusFailed, StatusCanceled:
+		return true
+	}
+	return false
+}
+
+// setProgress는 진행 상황을 갱신합니다
+func (j *Job) setProgress(done, total int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.progress = Progress{Done: done, Total: total}
+}
+
+// Queue는 고정된 동시성으로 작업을 실행하는 인메모리 대기열입니다
+type Queue struct {
+	mu        sync.RWMutex
+	jobs      map[string]*Job
+	pending   chan *Job
+	timeout   time.Duration
+	retention time.Duration
+	wg        sync.WaitGroup
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+// NewQueue는 Queue를 생성하고 워커를 시작합니다
+// timeout은 작업별 최대 실행 시간, retention은 종료된 작업의 보관 기간입니다
+func NewQueue(concurrency, queueSize int, timeout, retention time.Duration) *Queue {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	q := &Queue{
+		jobs:      make(map[string]*Job),
+		pending:   make(chan *Job, queueSize),
+		timeout:   timeout,
+		retention: retention,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	for i := 0; i < concurrency; i++ {
+		q.wg.Add(1)
+		go q.worker()
+	}
+
+	return q
+}
+
+// Submit은 작업을 대기열에 추가합니다
+// cleanup은 작업이 어떤 상태로든 종료된 후 호출됩니다 (nil 가능)
+func (q *Queue) Submit(run Func, cleanup func()) (*Job, error) {
+	q.prune()
+
+	ctx, cancel := context.WithCancel(q.ctx)
+	job := &Job{
+		id:        uuid.NewString(),
+		status:    StatusQueued,
+		createdAt: time.Now(),
+		run:       run,
+		cleanup:   cleanup,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	// 종료 후 추가된 작업은 실행할 워커가 없어 cleanup도 호출되지 않으므로 거부
+	if q.ctx.Err() != nil {
+		cancel()
+		return nil, ErrShuttingDown
+	}
+
+	select {
+	case q.pending <- job:
+		q.jobs[job.id] = job
+		return job, nil
+	default:
+		cancel()
+		return nil, ErrQueueFull
+	}
+}
+
+// Get은 작업을 조회합니다
+func (q *Queue) Get(id string) (*Job, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	job, ok := q.jobs[id]
+	return job, ok
+}
+
+// Cancel은 대기 중이거나 실행 중인 작업을 취소합니다
+func (q *Queue) Cancel(id string) (*Job, error) {
+	job, ok := q.Get(id)
+	if !ok {
+		return nil, fmt.Errorf("job not found: %s", id)
+	}
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	if job.finished() {
+		return job, ErrJobFinished
+	}
+
+	job.cancel()
+
+	// 대기 중인 작업은 즉시 취소 상태로 전환 (워커는 실행하지 않고 건너뜀)
+	if job.status == StatusQueued {
+		now := time.Now()
+		job.status = StatusCanceled
+		job.err = context.Canceled
+		job.finishedAt = &now
+	}
+
+	return job, nil
+}
+
+// Shutdown은 모든 작업을 취소하고 워커 종료를 기다립니다
+func (q *Queue) Shutdown(ctx context.Context) error {
+	// Submit과 같은 잠금 아래에서 취소해, 종료 전에 추가된 작업은 워커가 모두 정리하도록 함
+	q.mu.Lock()
+	q.cancel()
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// worker는 대기열에서 작업을 꺼내 실행합니다
+func (q *Queue) worker() {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case <-q.ctx.Done():
+			q.drain()
+			return
+		case job := <-q.pending:
+			q.execute(job)
+		}
+	}
+}
+
+// drain은 종료 시 남은 대기 작업을 정리합니다
+func (q *Queue) drain() {
+	for {
+		select {
+		case job := <-q.pending:
+			q.execute(job)
+		default:
+			return
+		}
+	}
+}
+
+// execute는 단일 작업을 실행합니다
+func (q *Queue) execute(job *Job) {
+	if job.cleanup != nil {
+		defer job.cleanup()
+	}
+
+	job.mu.Lock()
+	if job.status != StatusQueued || job.ctx.Err() != nil {
+		// 대기 중 취소된 작업
+		if !job.finished() {
+			now := time.Now()
+			job.status = StatusCanceled
+			job.err = job.ctx.Err()
+			job.finishedAt = &now
+		}
+		job.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	job.status = StatusRunning
+	job.startedAt = &now
+	job.mu.Unlock()
+
+	ctx := job.ctx
+	if q.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, q.timeout)
+		defer cancel()
+	}
+
+	result, err := q.runSafely(ctx, job)
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	finishedAt := time.Now()
+	job.finishedAt = &finishedAt
+	job.result = result
+	job.err = err
+
+	switch {
+	case err == nil:
+		job.status = StatusCompleted
+	case errors.Is(job.ctx.Err(), context.Canceled):
+		job.status = StatusCanceled
+	default:
+		job.status = StatusFailed
+	}
+
+	job.cancel()
+}
+
+// runSafely는 작업 실행 중 발생한 panic을 에러로 변환합니다
+func (q *Queue) runSafely(ctx context.Context, job *Job) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return job.run(ctx, job.setProgress)
+}
+
+// prune은 보관 기간이 지난 종료된 작업을 제거합니다
+func (q *Queue) prune() {
+	if q.retention <= 0 {
+		return
+	}
+
+	cutoff := time.Now().Add(-q.retention)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id, job := range q.jobs {
+		job.mu.RLock()
+		expired := job.finished() && job.finishedAt != nil && job.finishedAt.Before(cutoff)
+		job.mu.RUnlock()
+
+		if expired {
+			delete(q.jobs, id)
+		}
+	}
+}
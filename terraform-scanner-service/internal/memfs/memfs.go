@@ -0,0 +1,180 @@
+package memfs
+
+import (
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FS는 슬래시로 구분된 경로를 파일 내용에 대응시킨 읽기 전용 인메모리 파일 시스템입니다
+// 디렉토리는 파일 경로로부터 암묵적으로 만들어집니다 (예: "envs/prod/main.tf" → "envs", "envs/prod")
+type FS struct {
+	files   map[string][]byte
+	modTime time.Time
+}
+
+// New는 빈 FS를 생성합니다
+// modTime은 모든 파일과 디렉토리의 수정 시각으로 보고됩니다 (예: 커밋 시각)
+func New(modTime time.Time) *FS {
+	return &FS{
+		files:   make(map[string][]byte),
+		modTime: modTime,
+	}
+}
+
+// Add는 파일을 추가합니다 (같은 경로가 있으면 덮어씀)
+func (f *FS) Add(name string, data []byte) {
+	f.files[path.Clean(name)] = data
+}
+
+// Len은 파일 수를 반환합니다
+func (f *FS) Len() int {
+	return len(f.files)
+}
+
+// Open은 fs.FS 인터페이스를 구현합니다
+func (f *FS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	if data, ok := f.files[name]; ok {
+		return &file{info: f.info(name, int64(len(data)), false), data: data}, nil
+	}
+
+	entries, ok := f.children(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &dir{info: f.info(name, 0, true), entries: entries}, nil
+}
+
+// ReadFile은 fs.ReadFileFS 인터페이스를 구현합니다 (내용 복사본 반환)
+func (f *FS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+
+	data, ok := f.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// children은 디렉토리의 바로 아래 항목을 이름순으로 반환합니다
+// 디렉토리가 없으면 false를 반환합니다
+func (f *FS) children(name string) ([]fs.DirEntry, bool) {
+	prefix := ""
+	if name != "." {
+		prefix = name + "/"
+	}
+
+	found := name == "."
+	seen := make(map[string]bool)
+	var entries []fs.DirEntry
+
+	for filePath, data := range f.files {
+		if !strings.HasPrefix(filePath, prefix) {
+			continue
+		}
+		found = true
+
+		rest := strings.TrimPrefix(filePath, prefix)
+		child, _, isDir := strings.Cut(rest, "/")
+		if seen[child] {
+			continue
+		}
+		seen[child] = true
+
+		if isDir {
+			entries = append(entries, f.info(prefix+child, 0, true))
+		} else {
+			entries = append(entries, f.info(filePath, int64(len(data)), false))
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, found
+}
+
+// info는 경로의 파일 정보를 생성합니다
+func (f *FS) info(name string, size int64, isDir bool) *fileInfo {
+	mode := fs.FileMode(0444)
+	if isDir {
+		mode = fs.ModeDir | 0555
+	}
+	return &fileInfo{name: path.Base(name), size: size, mode: mode, modTime: f.modTime}
+}
+
+// fileInfo는 fs.FileInfo와 fs.DirEntry를 구현합니다
+type fileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (i *fileInfo) Name() string               { return i.name }
+func (i *fileInfo) Size() int64                { return i.size }
+func (i *fileInfo) Mode() fs.FileMode          { return i.mode }
+func (i *fileInfo) ModTime() time.Time         { return i.modTime }
+func (i *fileInfo) IsDir() bool                { return i.mode.IsDir() }
+func (i *fileInfo) Sys() interface{}           { return nil }
+func (i *fileInfo) Type() fs.FileMode          { return i.mode.Type() }
+func (i *fileInfo) Info() (fs.FileInfo, error) { return i, nil }
+
+// file은 열린 파일입니다
+type file struct {
+	info   *fileInfo
+	data   []byte
+	offset int64
+}
+
+func (f *file) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *file) Close() error               { return nil }
+
+func (f *file) Read(b []byte) (int, error) {
+	if f.offset >= int64(len(f.data)) {
+		return 0, io.EOF
+	}
+	n := copy(b, f.data[f.offset:])
+	f.offset += int64(n)
+	return n, nil
+}
+
+// dir은 열린 디렉토리입니다
+type dir struct {
+	info    *fileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *dir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *dir) Close() error               { return nil }
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+// ReadDir은 fs.ReadDirFile 인터페이스를 구현합니다
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return remaining, nil
+	}
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(remaining) {
+		n = len(remaining)
+	}
+	d.offset += n
+	return remaining[:n], nil
+}
@@ -0,0 +1,85 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// checkstyleRenderer는 Checkstyle XML 형식으로 출력합니다
+// 대상 파일마다 file 요소를, 실패한 misconfiguration마다 error 요소를 만듭니다
+type checkstyleRenderer struct{}
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+func (checkstyleRenderer) Render(w io.Writer, results []*types.ScanResult, _ Options) error {
+	report := checkstyleReport{Version: "4.3"}
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			file := checkstyleFile{Name: result.Target}
+
+			for _, misconfig := range failures(result) {
+				line, _ := lineRange(misconfig)
+				file.Errors = append(file.Errors, checkstyleError{
+					Line:     line,
+					Column:   1,
+					Severity: checkstyleSeverity(misconfig.Severity),
+					Message:  misconfig.Message,
+					Source:   "terraform-scanner." + misconfig.ID,
+				})
+			}
+
+			report.Files = append(report.Files, file)
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to encode checkstyle: %w", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (checkstyleRenderer) ContentType() string { return "application/xml" }
+
+func (checkstyleRenderer) Extension() string { return "xml" }
+
+// checkstyleSeverity는 심각도를 Checkstyle 등급으로 변환합니다
+func checkstyleSeverity(severity string) string {
+	switch strings.ToUpper(severity) {
+	case types.SeverityCritical, types.SeverityHigh:
+		return "error"
+	case types.SeverityMedium:
+		return "warning"
+	default:
+		return "info"
+	}
+}
@@ -0,0 +1,51 @@
+package report
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestCheckstyleRender(t *testing.T) {
+	var report checkstyleReport
+	if err := xml.Unmarshal(render(t, "checkstyle", testResults(), Options{}), &report); err != nil {
+		t.Fatalf("invalid Checkstyle XML: %v", err)
+	}
+
+	// 대상마다 file 요소 (실패 없는 파일 포함), 실패 항목만 error 요소
+	want := []checkstyleFile{
+		{
+			Name: "main.tf",
+			Errors: []checkstyleError{
+				{Line: 3, Column: 1, Severity: "error", Message: "bucket logs is not encrypted", Source: "terraform-scanner.TEST-001"},
+				{Line: 10, Column: 1, Severity: "error", Message: "bucket tmp is not encrypted", Source: "terraform-scanner.TEST-001"},
+				{Line: 1, Column: 1, Severity: "warning", Message: "bucket logs has no versioning", Source: "terraform-scanner.TEST-002"},
+			},
+		},
+		{Name: "empty.tf"},
+	}
+	if report.Version != "4.3" || !reflect.DeepEqual(report.Files, want) {
+		t.Errorf("checkstyle %s = %+v, want %+v", report.Version, report.Files, want)
+	}
+}
+
+func TestCheckstyleSeverity(t *testing.T) {
+	tests := []struct {
+		severity string
+		want     string
+	}{
+		{types.SeverityCritical, "error"},
+		{types.SeverityHigh, "error"},
+		{"medium", "warning"},
+		{types.SeverityLow, "info"},
+		{types.SeverityUnknown, "info"},
+	}
+
+	for _, tt := range tests {
+		if got := checkstyleSeverity(tt.severity); got != tt.want {
+			t.Errorf("checkstyleSeverity(%q) = %q, want %q", tt.severity, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// gitlabRenderer는 GitLab Code Quality 리포트 (JSON 배열) 로 출력합니다
+// 실패한 misconfiguration마다 이슈 하나를 만들며, fingerprint는 리포트 안에서 유일합니다
+type gitlabRenderer struct{}
+
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end,omitempty"`
+}
+
+func (gitlabRenderer) Render(w io.Writer, results []*types.ScanResult, _ Options) error {
+	issues := []gitlabIssue{}
+	fingerprints := newFingerprinter()
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			for _, misconfig := range failures(result) {
+				begin, end := lineRange(misconfig)
+				issues = append(issues, gitlabIssue{
+					Description: fmt.Sprintf("%s: %s", misconfig.Title, misconfig.Message),
+					CheckName:   misconfig.ID,
+					Fingerprint: fingerprints.next(result.Target, misconfig),
+					Severity:    gitlabSeverity(misconfig.Severity),
+					Location: gitlabLocation{
+						Path:  result.Target,
+						Lines: gitlabLines{Begin: begin, End: end},
+					},
+				})
+			}
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(issues)
+}
+
+func (gitlabRenderer) ContentType() string { return "application/json" }
+
+func (gitlabRenderer) Extension() string { return "json" }
+
+// gitlabSeverity는 심각도를 Code Quality 등급으로 변환합니다
+func gitlabSeverity(severity string) string {
+	switch strings.ToUpper(severity) {
+	case types.SeverityCritical:
+		return "blocker"
+	case types.SeverityHigh:
+		return "critical"
+	case types.SeverityMedium:
+		return "major"
+	case types.SeverityLow:
+		return "minor"
+	default:
+		return "info"
+	}
+}
+
+// lineRange는 원인 위치의 라인 범위를 반환합니다 (위치가 없으면 1번 라인)
+func lineRange(misconfig types.Misconfiguration) (int, int) {
+	cause := misconfig.CauseMetadata
+	if cause == nil || cause.StartLine <= 0 {
+		return 1, 1
+	}
+	if cause.EndLine < cause.StartLine {
+		return cause.StartLine, cause.StartLine
+	}
+	return cause.StartLine, cause.EndLine
+}
+
+// fingerprinter는 리포트 안에서 유일한 fingerprint를 만듭니다
+// 스캔 결과의 fingerprint에 리소스와 시작 라인을 더해 해시하므로 항목 순서와 무관합니다
+// 모든 값이 같은 항목이 반복될 때만 순번을 더합니다
+type fingerprinter struct {
+	seen map[string]int
+}
+
+func newFingerprinter() *fingerprinter {
+	return &fingerprinter{seen: make(map[string]int)}
+}
+
+func (f *fingerprinter) next(target string, misconfig types.Misconfiguration) string {
+	id := misconfig.Fingerprint
+	if id == "" {
+		id = strings.Join([]string{misconfig.ID, target, misconfig.Message}, "\x00")
+	}
+
+	var resource string
+	if cause := misconfig.CauseMetadata; cause != nil {
+		resource = cause.Resource
+	}
+	begin, _ := lineRange(misconfig)
+	base := fmt.Sprintf("%s\x00%s\x00%d", id, resource, begin)
+
+	occurrence := f.seen[base]
+	f.seen[base]++
+	if occurrence > 0 {
+		base = fmt.Sprintf("%s\x00%d", base, occurrence)
+	}
+
+	sum := sha256.Sum256([]byte(base))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,117 @@
+package report
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// renderGitLab은 결과를 GitLab Code Quality 이슈 목록으로 출력합니다
+func renderGitLab(t *testing.T, results []*types.ScanResult) []gitlabIssue {
+	t.Helper()
+
+	var issues []gitlabIssue
+	if err := json.Unmarshal(render(t, "gitlab", results, Options{}), &issues); err != nil {
+		t.Fatalf("invalid Code Quality JSON: %v", err)
+	}
+	return issues
+}
+
+func TestGitLabRender(t *testing.T) {
+	issues := renderGitLab(t, testResults())
+
+	type issue struct {
+		CheckName, Severity, Path string
+		Begin, End                int
+	}
+	var got []issue
+	for _, i := range issues {
+		got = append(got, issue{i.CheckName, i.Severity, i.Location.Path, i.Location.Lines.Begin, i.Location.Lines.End})
+	}
+
+	// 실패 항목만, 심각도가 높은 순 (위치가 없으면 1번 라인)
+	want := []issue{
+		{"TEST-001", "critical", "main.tf", 3, 7},
+		{"TEST-001", "critical", "main.tf", 10, 10},
+		{"TEST-002", "major", "main.tf", 1, 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("issues = %+v, want %+v", got, want)
+	}
+	if issues[0].Description != "Encryption: bucket logs is not encrypted" {
+		t.Errorf("description = %q", issues[0].Description)
+	}
+
+	// 결과가 없으면 빈 배열
+	if out := string(render(t, "gitlab", []*types.ScanResult{{}}, Options{})); out != "[]\n" {
+		t.Errorf("empty output = %q, want []", out)
+	}
+}
+
+func TestGitLabFingerprints(t *testing.T) {
+	// 같은 정책, 같은 리소스가 다른 라인에 두 번 보고된 경우
+	duplicate := func(startLine int) types.Misconfiguration {
+		return types.Misconfiguration{
+			ID:            "TEST-001",
+			Message:       "bucket logs is not encrypted",
+			Severity:      types.SeverityHigh,
+			Status:        "FAIL",
+			Fingerprint:   "fp-logs",
+			CauseMetadata: &types.CauseMetadata{Resource: "aws_s3_bucket.logs", StartLine: startLine},
+		}
+	}
+	results := func(misconfigs ...types.Misconfiguration) []*types.ScanResult {
+		return []*types.ScanResult{{Results: []types.Result{{Target: "main.tf", Misconfigurations: misconfigs}}}}
+	}
+	fingerprints := func(issues []gitlabIssue) map[int]string {
+		byLine := make(map[int]string)
+		for _, issue := range issues {
+			byLine[issue.Location.Lines.Begin] = issue.Fingerprint
+		}
+		return byLine
+	}
+
+	forward := fingerprints(renderGitLab(t, results(duplicate(3), duplicate(10))))
+	reversed := fingerprints(renderGitLab(t, results(duplicate(10), duplicate(3))))
+
+	if forward[3] == forward[10] {
+		t.Errorf("fingerprints are not unique: %v", forward)
+	}
+	if !reflect.DeepEqual(forward, reversed) {
+		t.Errorf("fingerprints changed when findings were reordered: %v != %v", forward, reversed)
+	}
+
+	// 다른 항목이 추가돼도 기존 항목의 fingerprint는 유지
+	added := fingerprints(renderGitLab(t, results(duplicate(1), duplicate(3), duplicate(10))))
+	if added[3] != forward[3] || added[10] != forward[10] {
+		t.Errorf("fingerprints changed when a finding was added: %v != %v", added, forward)
+	}
+
+	// 모든 값이 같은 항목도 리포트 안에서 유일
+	same := renderGitLab(t, results(duplicate(3), duplicate(3)))
+	if same[0].Fingerprint == same[1].Fingerprint {
+		t.Errorf("identical findings share fingerprint %s", same[0].Fingerprint)
+	}
+}
+
+func TestGitLabSeverity(t *testing.T) {
+	tests := []struct {
+		severity string
+		want     string
+	}{
+		{types.SeverityCritical, "blocker"},
+		{types.SeverityHigh, "critical"},
+		{types.SeverityMedium, "major"},
+		{"low", "minor"},
+		{types.SeverityUnknown, "info"},
+		{"", "info"},
+	}
+
+	for _, tt := range tests {
+		if got := gitlabSeverity(tt.severity); got != tt.want {
+			t.Errorf("gitlabSeverity(%q) = %q, want %q", tt.severity, got, tt.want)
+		}
+	}
+}
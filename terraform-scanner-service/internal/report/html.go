@@ -0,0 +1,234 @@
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// htmlRenderer는 외부 리소스 없이 열 수 있는 단일 HTML 리포트를 출력합니다
+// 심각도 요약, 필터 가능한 항목 표, 항목별 코드와 해결 방법, 참고 링크를 포함합니다
+type htmlRenderer struct{}
+
+// htmlReport는 HTML 템플릿에 전달하는 데이터입니다
+type htmlReport struct {
+	Title      string
+	CreatedAt  string
+	Severities []string
+	Counts     map[string]int
+	Targets    []htmlTarget
+	Findings   []htmlFinding
+}
+
+// htmlTarget은 대상별 요약입니다
+type htmlTarget struct {
+	Name      string
+	Successes int
+	Failures  int
+	Baselined int
+}
+
+// htmlFinding은 표의 행 하나입니다
+type htmlFinding struct {
+	Index      int
+	Target     string
+	Location   string
+	Resolution string
+	References []string
+	Code       []types.CodeLine
+	types.Misconfiguration
+}
+
+func (htmlRenderer) Render(w io.Writer, results []*types.ScanResult, opts Options) error {
+	data := htmlReport{
+		Title:      "Terraform Scan Report",
+		CreatedAt:  time.Now().Format("2006-01-02 15:04:05 MST"),
+		Severities: severitiesDesc(),
+	}
+
+	if name := mergeResults(results, opts.ArtifactName).ArtifactName; name != "" {
+		data.Title = fmt.Sprintf("Terraform Scan Report: %s", name)
+	}
+
+	var all []types.Misconfiguration
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			target := htmlTarget{Name: result.Target}
+			if summary := result.MisconfSummary; summary != nil {
+				target.Successes, target.Failures, target.Baselined = summary.Successes, summary.Failures, summary.Baselined
+			}
+			data.Targets = append(data.Targets, target)
+
+			for _, misconfig := range failures(result) {
+				all = append(all, misconfig)
+				data.Findings = append(data.Findings, newHTMLFinding(len(data.Findings)+1, result.Target, misconfig, opts.policy(misconfig)))
+			}
+		}
+	}
+	data.Counts = countSeverities(all)
+
+	if err := htmlTemplate.Execute(w, data); err != nil {
+		return fmt.Errorf("failed to execute html template: %w", err)
+	}
+	return nil
+}
+
+func (htmlRenderer) ContentType() string { return "text/html; charset=utf-8" }
+
+func (htmlRenderer) Extension() string { return "html" }
+
+// newHTMLFinding은 misconfiguration을 표의 행으로 변환합니다
+func newHTMLFinding(index int, target string, misconfig types.Misconfiguration, meta *types.PolicyMetadata) htmlFinding {
+	finding := htmlFinding{
+		Index:            index,
+		Target:           target,
+		Location:         location(target, misconfig),
+		Resolution:       misconfig.Resolution,
+		References:       misconfig.References,
+		Misconfiguration: misconfig,
+	}
+
+	if meta != nil {
+		if finding.Resolution == "" {
+			finding.Resolution = meta.Resolution
+		}
+		if len(finding.References) == 0 {
+			finding.References = meta.References
+		}
+	}
+	if len(finding.References) == 0 && misconfig.PrimaryURL != "" {
+		finding.References = []string{misconfig.PrimaryURL}
+	}
+
+	if cause := misconfig.CauseMetadata; cause != nil && cause.Code != nil {
+		finding.Code = cause.Code.Lines
+	}
+
+	return finding
+}
+
+var htmlTemplate = template.Must(template.New("html").Funcs(template.FuncMap{
+	"lower": strings.ToLower,
+}).Parse(htmlSource))
+
+const htmlSource = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{ .Title }}</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #1f2328; }
+  h1 { font-size: 1.6rem; margin-bottom: 0.2rem; }
+  .meta { color: #656d76; margin-bottom: 1.5rem; }
+  .cards { display: flex; gap: 0.8rem; margin-bottom: 1.5rem; flex-wrap: wrap; }
+  .card { border-radius: 6px; padding: 0.8rem 1.2rem; min-width: 7rem; color: #fff; }
+  .card .count { font-size: 1.8rem; font-weight: 600; }
+  .critical { background: #8b0000; } .high { background: #d1242f; } .medium { background: #bf8700; }
+  .low { background: #0969da; } .unknown { background: #6e7781; }
+  .badge { border-radius: 4px; padding: 0.1rem 0.4rem; color: #fff; font-size: 0.8rem; font-weight: 600; }
+  table { border-collapse: collapse; width: 100%; margin-bottom: 1.5rem; }
+  th, td { border: 1px solid #d0d7de; padding: 0.4rem 0.6rem; text-align: left; vertical-align: top; }
+  th { background: #f6f8fa; }
+  tr.finding { cursor: pointer; }
+  tr.finding:hover { background: #f6f8fa; }
+  tr.detail td { background: #fbfcfd; }
+  pre { background: #f6f8fa; padding: 0.6rem; overflow-x: auto; font-size: 0.85rem; }
+  .filters { margin-bottom: 0.8rem; display: flex; gap: 1rem; align-items: center; flex-wrap: wrap; }
+  .filters input[type=search] { padding: 0.3rem; width: 20rem; }
+  .hidden { display: none; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+<div class="meta">Generated {{ .CreatedAt }}</div>
+
+<h2>Summary</h2>
+<div class="cards">
+{{- range .Severities }}
+  <div class="card {{ lower . }}"><div class="count">{{ index $.Counts . }}</div>{{ . }}</div>
+{{- end }}
+</div>
+
+<table>
+  <tr><th>Target</th><th>Successes</th><th>Failures</th><th>Baselined</th></tr>
+{{- range .Targets }}
+  <tr><td>{{ .Name }}</td><td>{{ .Successes }}</td><td>{{ .Failures }}</td><td>{{ .Baselined }}</td></tr>
+{{- end }}
+</table>
+
+<h2>Findings</h2>
+{{- if .Findings }}
+<div class="filters">
+{{- range .Severities }}
+  <label><input type="checkbox" class="severity-filter" value="{{ . }}" checked> {{ . }}</label>
+{{- end }}
+  <input type="search" id="search" placeholder="Filter by ID, resource, file or message">
+</div>
+
+<table id="findings">
+  <tr><th>Severity</th><th>ID</th><th>Title</th><th>Location</th><th>Message</th></tr>
+{{- range .Findings }}
+  <tr class="finding" data-severity="{{ .Severity }}" data-index="{{ .Index }}">
+    <td><span class="badge {{ lower .Severity }}">{{ .Severity }}</span></td>
+    <td>{{ .ID }}</td>
+    <td>{{ .Title }}</td>
+    <td>{{ .Location }}</td>
+    <td>{{ .Message }}</td>
+  </tr>
+  <tr class="detail hidden" data-detail="{{ .Index }}">
+    <td colspan="5">
+      {{- if .Description }}<p>{{ .Description }}</p>{{ end }}
+      {{- if .Code }}
+      <pre>{{ range .Code }}{{ printf "%4d" .Number }} | {{ .Content }}
+{{ end }}</pre>
+      {{- end }}
+      {{- if .Resolution }}<p><strong>Resolution:</strong> {{ .Resolution }}</p>{{ end }}
+      {{- if .References }}
+      <p><strong>References:</strong></p>
+      <ul>{{ range .References }}<li><a href="{{ . }}" target="_blank" rel="noopener">{{ . }}</a></li>{{ end }}</ul>
+      {{- end }}
+    </td>
+  </tr>
+{{- end }}
+</table>
+{{- else }}
+<p>No misconfigurations found.</p>
+{{- end }}
+
+<script>
+(function () {
+  var table = document.getElementById("findings");
+  if (!table) { return; }
+
+  function apply() {
+    var enabled = {};
+    document.querySelectorAll(".severity-filter").forEach(function (box) { enabled[box.value] = box.checked; });
+    var query = document.getElementById("search").value.toLowerCase();
+
+    table.querySelectorAll("tr.finding").forEach(function (row) {
+      var visible = enabled[row.dataset.severity] !== false && row.textContent.toLowerCase().indexOf(query) !== -1;
+      row.classList.toggle("hidden", !visible);
+      if (!visible) {
+        table.querySelector('tr[data-detail="' + row.dataset.index + '"]').classList.add("hidden");
+      }
+    });
+  }
+
+  document.querySelectorAll(".severity-filter").forEach(function (box) { box.addEventListener("change", apply); });
+  document.getElementById("search").addEventListener("input", apply);
+
+  table.querySelectorAll("tr.finding").forEach(function (row) {
+    row.addEventListener("click", function () {
+      table.querySelector('tr[data-detail="' + row.dataset.index + '"]').classList.toggle("hidden");
+    });
+  });
+})();
+</script>
+</body>
+</html>
+`
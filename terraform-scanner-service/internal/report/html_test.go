@@ -0,0 +1,58 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestHTMLRender(t *testing.T) {
+	results := testResults()
+	results[0].ArtifactName = `<img src=x onerror=alert(1)>`
+	misconfig := &results[0].Results[0].Misconfigurations[1]
+	misconfig.Message = `bucket "logs" <script>alert(1)</script>`
+	misconfig.PrimaryURL = `javascript:alert(1)`
+	misconfig.CauseMetadata.Code = &types.CodeLines{Lines: []types.CodeLine{
+		{Number: 3, Content: `resource "aws_s3_bucket" "logs" {`, IsCause: true},
+	}}
+
+	out := string(render(t, "html", results, Options{}))
+
+	tests := []struct {
+		name    string
+		want    string
+		notWant string
+	}{
+		{name: "title", want: "<title>Terraform Scan Report: &lt;img src=x onerror=alert(1)&gt;</title>", notWant: "<img src=x"},
+		{name: "message", want: "bucket &#34;logs&#34; &lt;script&gt;alert(1)&lt;/script&gt;", notWant: "<script>alert(1)"},
+		{name: "code", want: "   3 | resource &#34;aws_s3_bucket&#34; &#34;logs&#34; {"},
+		{name: "unsafe reference URL", want: `href="#ZgotmplZ"`, notWant: `href="javascript:`},
+		{name: "severity counts", want: `<div class="card high"><div class="count">2</div>HIGH</div>`},
+		{name: "target summary", want: "<tr><td>main.tf</td><td>1</td><td>3</td><td>1</td></tr>"},
+		{name: "passed policy", notWant: "TEST-003"},
+		{name: "baselined policy", notWant: "TEST-004"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.want != "" && !strings.Contains(out, tt.want) {
+				t.Errorf("output does not contain %q", tt.want)
+			}
+			if tt.notWant != "" && strings.Contains(out, tt.notWant) {
+				t.Errorf("output contains %q", tt.notWant)
+			}
+		})
+	}
+}
+
+func TestHTMLRenderEmpty(t *testing.T) {
+	out := string(render(t, "html", []*types.ScanResult{{Results: []types.Result{{Target: "main.tf"}}}}, Options{}))
+
+	if !strings.Contains(out, "<p>No misconfigurations found.</p>") {
+		t.Errorf("output does not report that no misconfigurations were found")
+	}
+	if strings.Contains(out, `id="findings"`) {
+		t.Errorf("output contains the findings table")
+	}
+}
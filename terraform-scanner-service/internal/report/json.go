@@ -0,0 +1,26 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// jsonRenderer는 Trivy JSON 형식으로 출력합니다
+// 결과가 하나면 객체, 여러 개면 배열로 출력합니다
+type jsonRenderer struct{}
+
+func (jsonRenderer) Render(w io.Writer, results []*types.ScanResult, _ Options) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if len(results) == 1 {
+		return encoder.Encode(results[0])
+	}
+	return encoder.Encode(results)
+}
+
+func (jsonRenderer) ContentType() string { return "application/json" }
+
+func (jsonRenderer) Extension() string { return "json" }
@@ -0,0 +1,188 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// junitRenderer는 JUnit XML 형식으로 출력합니다
+// 스캔한 파일은 testsuite, 평가된 정책은 testcase가 됩니다
+// 실패한 정책은 failure, 기준선으로 분리된 정책은 skipped로 표시합니다
+type junitRenderer struct{}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",cdata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// RendersPasses는 PASS 항목이 있어야 통과한 정책을 testcase로 출력할 수 있음을 나타냅니다
+func (junitRenderer) RendersPasses() bool { return true }
+
+func (junitRenderer) Render(w io.Writer, results []*types.ScanResult, _ Options) error {
+	suites := junitTestSuites{Name: toolName}
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			suite := newJUnitSuite(result)
+			suite.Timestamp = scanResult.CreatedAt.Format("2006-01-02T15:04:05")
+
+			suites.Tests += suite.Tests
+			suites.Failures += suite.Failures
+			suites.Skipped += suite.Skipped
+			suites.Suites = append(suites.Suites, suite)
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return fmt.Errorf("failed to encode junit: %w", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (junitRenderer) ContentType() string { return "application/xml" }
+
+func (junitRenderer) Extension() string { return "xml" }
+
+// newJUnitSuite는 대상 파일의 결과를 정책별 testcase로 묶습니다
+// 한 정책이 여러 리소스에서 실패하면 하나의 failure에 모두 기록합니다
+func newJUnitSuite(result types.Result) junitTestSuite {
+	suite := junitTestSuite{Name: result.Target}
+
+	var order []string
+	byPolicy := make(map[string][]types.Misconfiguration)
+	for _, misconfig := range result.Misconfigurations {
+		if _, ok := byPolicy[misconfig.ID]; !ok {
+			order = append(order, misconfig.ID)
+		}
+		byPolicy[misconfig.ID] = append(byPolicy[misconfig.ID], misconfig)
+	}
+
+	for _, id := range order {
+		misconfigs := byPolicy[id]
+		testCase := junitTestCase{
+			Name:      fmt.Sprintf("[%s] %s: %s", misconfigs[0].Severity, id, misconfigs[0].Title),
+			ClassName: result.Target,
+		}
+
+		var failed []types.Misconfiguration
+		for _, misconfig := range misconfigs {
+			if misconfig.Status != "PASS" {
+				failed = append(failed, misconfig)
+			}
+		}
+
+		if len(failed) > 0 {
+			testCase.Failure = newJUnitFailure(result.Target, failed)
+			suite.Failures++
+		}
+
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	// 기준선으로 분리된 정책 중 실패로 보고되지 않은 정책
+	skipped := make(map[string]bool)
+	for _, misconfig := range result.Baselined {
+		if _, reported := byPolicy[misconfig.ID]; reported || skipped[misconfig.ID] {
+			continue
+		}
+		skipped[misconfig.ID] = true
+
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      fmt.Sprintf("[%s] %s: %s", misconfig.Severity, misconfig.ID, misconfig.Title),
+			ClassName: result.Target,
+			Skipped:   &junitSkipped{Message: "baselined"},
+		})
+		suite.Skipped++
+	}
+
+	suite.Tests = len(suite.TestCases)
+	return suite
+}
+
+// newJUnitFailure는 실패 항목의 메시지와 위치로 failure를 구성합니다
+func newJUnitFailure(target string, misconfigs []types.Misconfiguration) *junitFailure {
+	var lines []string
+	for _, misconfig := range misconfigs {
+		lines = append(lines, fmt.Sprintf("%s: %s", location(target, misconfig), misconfig.Message))
+	}
+
+	message := misconfigs[0].Message
+	if len(misconfigs) > 1 {
+		message = fmt.Sprintf("%d misconfigurations found", len(misconfigs))
+	}
+
+	if resolution := misconfigs[0].Resolution; resolution != "" {
+		lines = append(lines, "", "Resolution: "+resolution)
+	}
+	if url := misconfigs[0].PrimaryURL; url != "" {
+		lines = append(lines, "See: "+url)
+	}
+
+	return &junitFailure{
+		Message: message,
+		Type:    misconfigs[0].Severity,
+		Text:    strings.Join(lines, "\n"),
+	}
+}
+
+// location은 misconfiguration의 위치를 "파일:라인 (리소스)" 형태로 표시합니다
+func location(target string, misconfig types.Misconfiguration) string {
+	cause := misconfig.CauseMetadata
+	if cause == nil {
+		return target
+	}
+
+	loc := target
+	if cause.StartLine > 0 {
+		loc = fmt.Sprintf("%s:%d", target, cause.StartLine)
+		if cause.EndLine > cause.StartLine {
+			loc = fmt.Sprintf("%s-%d", loc, cause.EndLine)
+		}
+	}
+	if cause.Resource != "" {
+		loc = fmt.Sprintf("%s (%s)", loc, cause.Resource)
+	}
+	return loc
+}
@@ -0,0 +1,114 @@
+package report
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestJUnitRender(t *testing.T) {
+	out := render(t, "junit", testResults(), Options{})
+	if !strings.HasPrefix(string(out), xml.Header) {
+		t.Errorf("output does not start with the XML header")
+	}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(out, &suites); err != nil {
+		t.Fatalf("invalid JUnit XML: %v", err)
+	}
+	if suites.Tests != 4 || suites.Failures != 2 || suites.Skipped != 1 {
+		t.Errorf("testsuites tests/failures/skipped = %d/%d/%d, want 4/2/1", suites.Tests, suites.Failures, suites.Skipped)
+	}
+	if len(suites.Suites) != 2 {
+		t.Fatalf("testsuites = %d, want 2", len(suites.Suites))
+	}
+
+	// 정책마다 testcase 하나: 실패 (TEST-002, TEST-001 두 리소스), 통과 (TEST-003), 기준선 (TEST-004)
+	tests := []struct {
+		name    string
+		failure string
+		skipped bool
+	}{
+		{name: "[MEDIUM] TEST-002: Versioning", failure: "bucket logs has no versioning"},
+		{name: "[HIGH] TEST-001: Encryption", failure: "2 misconfigurations found"},
+		{name: "[LOW] TEST-003: Logging"},
+		{name: "[CRITICAL] TEST-004: Public access", skipped: true},
+	}
+
+	suite := suites.Suites[0]
+	if suite.Name != "main.tf" || suite.Timestamp != "2026-01-02T03:04:05" {
+		t.Errorf("testsuite = %q at %q", suite.Name, suite.Timestamp)
+	}
+	if len(suite.TestCases) != len(tests) {
+		t.Fatalf("testcases = %d, want %d", len(suite.TestCases), len(tests))
+	}
+	for i, tt := range tests {
+		testCase := suite.TestCases[i]
+		if testCase.Name != tt.name || testCase.ClassName != "main.tf" {
+			t.Errorf("testcase %d = %q (%s), want %q (main.tf)", i, testCase.Name, testCase.ClassName, tt.name)
+		}
+
+		var failure string
+		if testCase.Failure != nil {
+			failure = testCase.Failure.Message
+		}
+		if failure != tt.failure {
+			t.Errorf("testcase %d failure = %q, want %q", i, failure, tt.failure)
+		}
+		if skipped := testCase.Skipped != nil; skipped != tt.skipped {
+			t.Errorf("testcase %d skipped = %v, want %v", i, skipped, tt.skipped)
+		}
+	}
+
+	// 실패 본문에 리소스별 위치와 해결 방법
+	text := suite.TestCases[1].Failure.Text
+	for _, want := range []string{
+		"main.tf:3-7 (aws_s3_bucket.logs): bucket logs is not encrypted",
+		"main.tf:10 (aws_s3_bucket.tmp): bucket tmp is not encrypted",
+		"Resolution: Enable encryption",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("failure text %q does not contain %q", text, want)
+		}
+	}
+
+	if empty := suites.Suites[1]; empty.Name != "empty.tf" || empty.Tests != 0 {
+		t.Errorf("empty testsuite = %q with %d tests, want empty.tf with 0 tests", empty.Name, empty.Tests)
+	}
+}
+
+func TestJUnitRenderEscaping(t *testing.T) {
+	results := []*types.ScanResult{{Results: []types.Result{{
+		Target: "a&b.tf",
+		Misconfigurations: []types.Misconfiguration{{
+			ID:       "TEST-001",
+			Title:    `<"quoted">`,
+			Message:  "value ]]> ends cdata",
+			Severity: types.SeverityHigh,
+			Status:   "FAIL",
+		}},
+	}}}}
+
+	var suites junitTestSuites
+	if err := xml.Unmarshal(render(t, "junit", results, Options{}), &suites); err != nil {
+		t.Fatalf("invalid JUnit XML: %v", err)
+	}
+	testCase := suites.Suites[0].TestCases[0]
+	if testCase.Name != `[HIGH] TEST-001: <"quoted">` || testCase.ClassName != "a&b.tf" {
+		t.Errorf("testcase = %q (%s)", testCase.Name, testCase.ClassName)
+	}
+	if !strings.Contains(testCase.Failure.Text, "value ]]> ends cdata") {
+		t.Errorf("failure text = %q", testCase.Failure.Text)
+	}
+}
+
+func TestJUnitRendersPasses(t *testing.T) {
+	if !RendersPasses("junit") {
+		t.Errorf("RendersPasses(junit) = false, want true")
+	}
+	if format, _ := ForContentType("application/xml"); format != "junit" {
+		t.Errorf("ForContentType(application/xml) = %q, want junit", format)
+	}
+}
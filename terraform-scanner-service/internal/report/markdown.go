@@ -0,0 +1,129 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// markdownRenderer는 PR/MR 코멘트에 붙여넣기 위한 Markdown 형식으로 출력합니다
+// 요약 표 아래에 항목별로 접을 수 있는 상세 정보 (메시지, 위치, 코드, 해결 방법) 를 출력합니다
+type markdownRenderer struct{}
+
+func (markdownRenderer) Render(w io.Writer, results []*types.ScanResult, opts Options) error {
+	out := bufio.NewWriter(w)
+
+	fmt.Fprintln(out, "## Terraform Scan Results")
+	fmt.Fprintln(out)
+
+	// 요약 표
+	header := append([]string{"Target"}, severitiesDesc()...)
+	fmt.Fprintln(out, "| "+strings.Join(header, " | ")+" |")
+	fmt.Fprintln(out, "|"+strings.Repeat(" --- |", len(header)))
+
+	var all []types.Misconfiguration
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			failed := failures(result)
+			all = append(all, failed...)
+			fmt.Fprintln(out, markdownSummaryRow(markdownCell(result.Target), countSeverities(failed)))
+		}
+	}
+	if len(results) > 1 || len(all) > 0 {
+		fmt.Fprintln(out, markdownSummaryRow("**Total**", countSeverities(all)))
+	}
+	fmt.Fprintln(out)
+
+	if len(all) == 0 {
+		fmt.Fprintln(out, "No misconfigurations found.")
+		return out.Flush()
+	}
+
+	// 대상별 상세
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			failed := failures(result)
+			if len(failed) == 0 {
+				continue
+			}
+
+			fmt.Fprintf(out, "### %s\n\n", result.Target)
+			for _, misconfig := range failed {
+				writeMarkdownFinding(out, result.Target, misconfig, opts.policy(misconfig))
+			}
+		}
+	}
+
+	return out.Flush()
+}
+
+func (markdownRenderer) ContentType() string { return "text/markdown; charset=utf-8" }
+
+func (markdownRenderer) Extension() string { return "md" }
+
+// markdownSummaryRow는 심각도별 개수 행을 만듭니다
+func markdownSummaryRow(label string, counts map[string]int) string {
+	cells := []string{label}
+	for _, severity := range severitiesDesc() {
+		cells = append(cells, fmt.Sprintf("%d", counts[severity]))
+	}
+	return "| " + strings.Join(cells, " | ") + " |"
+}
+
+// writeMarkdownFinding은 항목 하나를 <details> 블록으로 출력합니다
+func writeMarkdownFinding(w io.Writer, target string, misconfig types.Misconfiguration, meta *types.PolicyMetadata) {
+	summary := fmt.Sprintf("<b>%s</b> %s: %s", html.EscapeString(misconfig.Severity), html.EscapeString(misconfig.ID), html.EscapeString(misconfig.Title))
+	if cause := misconfig.CauseMetadata; cause != nil && cause.Resource != "" {
+		summary += fmt.Sprintf(" (<code>%s</code>)", html.EscapeString(cause.Resource))
+	}
+
+	fmt.Fprintln(w, "<details>")
+	fmt.Fprintf(w, "<summary>%s</summary>\n\n", summary)
+
+	fmt.Fprintf(w, "%s\n\n", misconfig.Message)
+	fmt.Fprintf(w, "**Location:** `%s`\n\n", location(target, misconfig))
+
+	if cause := misconfig.CauseMetadata; cause != nil && cause.Code != nil && len(cause.Code.Lines) > 0 {
+		fmt.Fprintln(w, "```hcl")
+		for _, line := range cause.Code.Lines {
+			fmt.Fprintf(w, "%4d | %s\n", line.Number, line.Content)
+			if line.Truncated {
+				fmt.Fprintln(w, "     | ...")
+			}
+		}
+		fmt.Fprintln(w, "```")
+		fmt.Fprintln(w)
+	}
+
+	resolution := misconfig.Resolution
+	if resolution == "" && meta != nil {
+		resolution = meta.Resolution
+	}
+	if resolution != "" {
+		fmt.Fprintf(w, "**Resolution:** %s\n\n", resolution)
+	}
+
+	references := misconfig.References
+	if len(references) == 0 && misconfig.PrimaryURL != "" {
+		references = []string{misconfig.PrimaryURL}
+	}
+	if len(references) > 0 {
+		fmt.Fprintln(w, "**References:**")
+		for _, ref := range references {
+			fmt.Fprintf(w, "- %s\n", ref)
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "</details>")
+	fmt.Fprintln(w)
+}
+
+// markdownCell은 표 셀에서 구분자로 해석되는 문자를 이스케이프합니다
+func markdownCell(value string) string {
+	return strings.ReplaceAll(value, "|", `\|`)
+}
@@ -0,0 +1,63 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestMarkdownRender(t *testing.T) {
+	results := testResults()
+	results[0].Results[0].Target = "envs|prod/main.tf"
+	results[0].Results[0].Misconfigurations[1].Title = "<Encryption>"
+
+	tests := []struct {
+		name    string
+		results []*types.ScanResult
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "findings",
+			results: results,
+			want: []string{
+				"| Target | CRITICAL | HIGH | MEDIUM | LOW | UNKNOWN |",
+				`| envs\|prod/main.tf | 0 | 2 | 1 | 0 | 0 |`,
+				"| empty.tf | 0 | 0 | 0 | 0 | 0 |",
+				"| **Total** | 0 | 2 | 1 | 0 | 0 |",
+				"### envs|prod/main.tf",
+				"<summary><b>HIGH</b> TEST-001: &lt;Encryption&gt; (<code>aws_s3_bucket.logs</code>)</summary>",
+				"**Location:** `envs|prod/main.tf:3-7 (aws_s3_bucket.logs)`",
+				"**Resolution:** Enable encryption",
+				"- https://example.com/TEST-001",
+			},
+			notWant: []string{"TEST-003", "TEST-004", "No misconfigurations found."},
+		},
+		{
+			name:    "no findings",
+			results: []*types.ScanResult{{Results: []types.Result{{Target: "main.tf"}}}},
+			want: []string{
+				"| main.tf | 0 | 0 | 0 | 0 | 0 |",
+				"No misconfigurations found.",
+			},
+			notWant: []string{"**Total**", "<details>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := string(render(t, "markdown", tt.results, Options{}))
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output does not contain %q:\n%s", want, out)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(out, notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// FormatJSON은 기본 출력 형식 (Trivy JSON) 입니다
+const FormatJSON = "json"
+
+// Renderer는 스캔 결과를 특정 형식의 문서로 출력합니다
+type Renderer interface {
+	// Render는 스캔 결과를 w에 출력합니다
+	Render(w io.Writer, results []*types.ScanResult, opts Options) error
+
+	// ContentType은 HTTP 응답에 사용할 미디어 타입입니다
+	ContentType() string
+
+	// Extension은 결과 파일 확장자입니다 (점 제외)
+	Extension() string
+}
+
+// PassRenderer는 통과한 정책도 출력하는 렌더러입니다
+// 이 형식을 요청하면 스캔 결과에 PASS 항목을 포함해야 합니다
+type PassRenderer interface {
+	RendersPasses() bool
+}
+
+// RendersPasses는 형식이 PASS 항목을 필요로 하는지 확인합니다
+func RendersPasses(format string) bool {
+	renderer, ok := Get(format)
+	if !ok {
+		return false
+	}
+	passRenderer, ok := renderer.(PassRenderer)
+	return ok && passRenderer.RendersPasses()
+}
+
+// Options는 렌더링 옵션입니다
+type Options struct {
+	// Policies는 정책 ID별 메타데이터입니다 (규칙 설명에 사용, nil 가능)
+	Policies map[string]*types.PolicyMetadata
+
+	// Color는 터미널용 ANSI 색상을 사용할지 여부입니다 (table 형식)
+	Color bool
+
+	// Template은 template 형식에 사용할 Go 템플릿입니다
+	Template string
+
+	// ArtifactName은 여러 결과를 하나로 합칠 때 사용할 아티팩트 이름입니다
+	ArtifactName string
+}
+
+// policy는 misconfiguration에 해당하는 정책 메타데이터를 찾습니다
+func (o Options) policy(misconfig types.Misconfiguration) *types.PolicyMetadata {
+	if meta, ok := o.Policies[misconfig.ID]; ok {
+		return meta
+	}
+	return nil
+}
+
+// 형식 이름별 렌더러
+var (
+	renderers = make(map[string]Renderer)
+	order     []string // 등록 순서
+)
+
+func init() {
+	// 미디어 타입이 같은 형식은 먼저 등록된 형식이 Accept 협상에 사용됩니다
+	Register(FormatJSON, jsonRenderer{})
+	Register("sarif", sarifRenderer{})
+	Register("junit", junitRenderer{})
+	Register("table", tableRenderer{})
+	Register("markdown", markdownRenderer{})
+	Register(FormatTemplate, templateRenderer{})
+	Register("html", htmlRenderer{})
+	Register("gitlab", gitlabRenderer{})
+	Register("checkstyle", checkstyleRenderer{})
+}
+
+// Register는 렌더러를 형식 이름으로 등록합니다
+func Register(format string, renderer Renderer) {
+	format = strings.ToLower(format)
+	if _, exists := renderers[format]; exists {
+		panic(fmt.Sprintf("report format already registered: %s", format))
+	}
+	renderers[format] = renderer
+	order = append(order, format)
+}
+
+// Get은 형식 이름으로 렌더러를 찾습니다
+func Get(format string) (Renderer, bool) {
+	renderer, ok := renderers[strings.ToLower(format)]
+	return renderer, ok
+}
+
+// Formats는 등록된 형식 이름을 정렬해 반환합니다
+func Formats() []string {
+	formats := make([]string, len(order))
+	copy(formats, order)
+	sort.Strings(formats)
+	return formats
+}
+
+// ContentTypes는 Accept 협상에 사용할 미디어 타입을 등록 순서대로 반환합니다 (중복 제외)
+func ContentTypes() []string {
+	seen := make(map[string]bool)
+	var contentTypes []string
+	for _, format := range order {
+		if contentType := renderers[format].ContentType(); !seen[contentType] {
+			seen[contentType] = true
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+	return contentTypes
+}
+
+// ForContentType은 미디어 타입에 해당하는 형식 이름을 찾습니다
+// 같은 미디어 타입의 형식이 여러 개면 먼저 등록된 형식을 반환합니다
+func ForContentType(contentType string) (string, bool) {
+	for _, format := range order {
+		if renderers[format].ContentType() == contentType {
+			return format, true
+		}
+	}
+	return "", false
+}
+
+// failures는 대상의 실패 항목을 심각도가 높은 순으로 반환합니다
+func failures(result types.Result) []types.Misconfiguration {
+	var failed []types.Misconfiguration
+	for _, misconfig := range result.Misconfigurations {
+		if misconfig.Status != "PASS" {
+			failed = append(failed, misconfig)
+		}
+	}
+
+	sort.SliceStable(failed, func(i, j int) bool {
+		return types.SeverityRank(failed[i].Severity) > types.SeverityRank(failed[j].Severity)
+	})
+	return failed
+}
+
+// countSeverities는 실패 항목의 심각도별 개수를 집계합니다
+func countSeverities(misconfigs []types.Misconfiguration) map[string]int {
+	counts := make(map[string]int, len(types.Severities))
+	for _, severity := range types.Severities {
+		counts[severity] = 0
+	}
+
+	for _, misconfig := range misconfigs {
+		if misconfig.Status == "PASS" {
+			continue
+		}
+		severity := strings.ToUpper(misconfig.Severity)
+		if !types.IsValidSeverity(severity) {
+			severity = types.SeverityUnknown
+		}
+		counts[severity]++
+	}
+	return counts
+}
+
+// severitiesDesc는 심각도 목록을 높은 순으로 반환합니다
+func severitiesDesc() []string {
+	severities := make([]string, len(types.Severities))
+	for i, severity := range types.Severities {
+		severities[len(severities)-1-i] = severity
+	}
+	return severities
+}
@@ -0,0 +1,199 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// testResults는 렌더러 테스트에 사용하는 스캔 결과입니다
+// main.tf: HIGH 실패 2개 (같은 정책), MEDIUM 실패 1개 (위치 없음), LOW 통과 1개, 기준선 1개
+// empty.tf: 실패 없음
+func testResults() []*types.ScanResult {
+	return []*types.ScanResult{{
+		SchemaVersion: 2,
+		CreatedAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		ArtifactName:  "infra",
+		ArtifactType:  "terraform",
+		Results: []types.Result{
+			{
+				Target:         "main.tf",
+				Class:          "config",
+				Type:           "terraform",
+				MisconfSummary: &types.MisconfSummary{Successes: 1, Failures: 3, Baselined: 1},
+				Misconfigurations: []types.Misconfiguration{
+					{
+						ID:          "TEST-002",
+						Title:       "Versioning",
+						Message:     "bucket logs has no versioning",
+						Severity:    types.SeverityMedium,
+						Status:      "FAIL",
+						Fingerprint: "fp-versioning",
+					},
+					{
+						ID:          "TEST-001",
+						Title:       "Encryption",
+						Message:     "bucket logs is not encrypted",
+						Resolution:  "Enable encryption",
+						Severity:    types.SeverityHigh,
+						Status:      "FAIL",
+						PrimaryURL:  "https://example.com/TEST-001",
+						Fingerprint: "fp-logs",
+						CauseMetadata: &types.CauseMetadata{
+							Resource:  "aws_s3_bucket.logs",
+							StartLine: 3,
+							EndLine:   7,
+						},
+					},
+					{
+						ID:          "TEST-001",
+						Title:       "Encryption",
+						Message:     "bucket tmp is not encrypted",
+						Severity:    types.SeverityHigh,
+						Status:      "FAIL",
+						Fingerprint: "fp-tmp",
+						CauseMetadata: &types.CauseMetadata{
+							Resource:  "aws_s3_bucket.tmp",
+							StartLine: 10,
+						},
+					},
+					{
+						ID:       "TEST-003",
+						Title:    "Logging",
+						Message:  "No issues found",
+						Severity: types.SeverityLow,
+						Status:   "PASS",
+					},
+				},
+				Baselined: []types.Misconfiguration{
+					{
+						ID:       "TEST-004",
+						Title:    "Public access",
+						Message:  "bucket old is public",
+						Severity: types.SeverityCritical,
+						Status:   "FAIL",
+					},
+				},
+			},
+			{
+				Target: "empty.tf",
+				Class:  "config",
+				Type:   "terraform",
+			},
+		},
+	}}
+}
+
+// render는 형식 이름으로 렌더러를 찾아 결과를 출력합니다
+func render(t *testing.T, format string, results []*types.ScanResult, opts Options) []byte {
+	t.Helper()
+
+	renderer, ok := Get(format)
+	if !ok {
+		t.Fatalf("Get(%q) = not found", format)
+	}
+	var buf bytes.Buffer
+	if err := renderer.Render(&buf, results, opts); err != nil {
+		t.Fatalf("Render(%s) error = %v", format, err)
+	}
+	return buf.Bytes()
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		format      string
+		contentType string
+		extension   string
+		found       bool
+	}{
+		{format: "json", contentType: "application/json", extension: "json", found: true},
+		{format: "SARIF", contentType: "application/sarif+json", extension: "sarif", found: true},
+		{format: "yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			renderer, ok := Get(tt.format)
+			if ok != tt.found {
+				t.Fatalf("Get(%q) found = %v, want %v", tt.format, ok, tt.found)
+			}
+			if !ok {
+				return
+			}
+			if got := renderer.ContentType(); got != tt.contentType {
+				t.Errorf("ContentType() = %q, want %q", got, tt.contentType)
+			}
+			if got := renderer.Extension(); got != tt.extension {
+				t.Errorf("Extension() = %q, want %q", got, tt.extension)
+			}
+		})
+	}
+}
+
+func TestForContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        string
+		found       bool
+	}{
+		{contentType: "application/json", want: "json", found: true},
+		{contentType: "application/sarif+json", want: "sarif", found: true},
+		{contentType: "text/csv"},
+	}
+
+	for _, tt := range tests {
+		got, ok := ForContentType(tt.contentType)
+		if got != tt.want || ok != tt.found {
+			t.Errorf("ForContentType(%q) = %q, %v, want %q, %v", tt.contentType, got, ok, tt.want, tt.found)
+		}
+	}
+}
+
+func TestFailures(t *testing.T) {
+	var got []string
+	for _, misconfig := range failures(testResults()[0].Results[0]) {
+		got = append(got, misconfig.Severity+":"+misconfig.Message)
+	}
+
+	// PASS 항목 제외, 심각도가 높은 순 (같으면 원래 순서)
+	want := []string{
+		"HIGH:bucket logs is not encrypted",
+		"HIGH:bucket tmp is not encrypted",
+		"MEDIUM:bucket logs has no versioning",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("failures() = %v, want %v", got, want)
+	}
+}
+
+func TestJSONRender(t *testing.T) {
+	single := testResults()
+	multiple := append(testResults(), testResults()...)
+
+	tests := []struct {
+		name    string
+		results []*types.ScanResult
+		array   bool
+	}{
+		{name: "single result as object", results: single},
+		{name: "multiple results as array", results: multiple, array: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := render(t, "json", tt.results, Options{})
+
+			var decoded interface{}
+			if err := json.Unmarshal(out, &decoded); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			if _, isArray := decoded.([]interface{}); isArray != tt.array {
+				t.Errorf("JSON array = %v, want %v", isArray, tt.array)
+			}
+		})
+	}
+}
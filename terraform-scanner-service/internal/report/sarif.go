@@ -0,0 +1,288 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// SARIF 2.1.0 스키마
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName     = "terraform-scanner-service"
+)
+
+// sarifRenderer는 SARIF 2.1.0 형식으로 출력합니다
+// 정책은 rule, 실패한 misconfiguration은 result로 변환합니다 (PASS 항목 제외)
+type sarifRenderer struct{}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name,omitempty"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	FullDescription      sarifMessage       `json:"fullDescription"`
+	Help                 sarifHelp          `json:"help"`
+	HelpURI              string             `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	Properties           sarifProperties    `json:"properties"`
+}
+
+type sarifHelp struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifProperties struct {
+	Tags             []string `json:"tags"`
+	Precision        string   `json:"precision"`
+	SecuritySeverity string   `json:"security-severity"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           int               `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	LogicalLocations []sarifLogical        `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+	EndLine   int `json:"endLine"`
+}
+
+type sarifLogical struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func (sarifRenderer) Render(w io.Writer, results []*types.ScanResult, opts Options) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:  toolName,
+			Rules: []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+	ruleIndex := make(map[string]int)
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			for _, misconfig := range result.Misconfigurations {
+				if misconfig.Status == "PASS" {
+					continue
+				}
+
+				ruleID := misconfig.ID
+				index, ok := ruleIndex[ruleID]
+				if !ok {
+					index = len(run.Tool.Driver.Rules)
+					ruleIndex[ruleID] = index
+					run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSarifRule(misconfig, opts.policy(misconfig)))
+				}
+
+				run.Results = append(run.Results, newSarifResult(result.Target, misconfig, index))
+			}
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{run},
+	})
+}
+
+func (sarifRenderer) ContentType() string { return "application/sarif+json" }
+
+func (sarifRenderer) Extension() string { return "sarif" }
+
+// newSarifRule은 정책 메타데이터로 rule을 구성합니다
+// 메타데이터가 없으면 misconfiguration에 복사된 정책 정보를 사용합니다
+func newSarifRule(misconfig types.Misconfiguration, meta *types.PolicyMetadata) sarifRule {
+	title, description, resolution := misconfig.Title, misconfig.Description, misconfig.Resolution
+	references := misconfig.References
+	tags := []string{"security", "terraform"}
+
+	if meta != nil {
+		if meta.Title != "" {
+			title = meta.Title
+		}
+		if meta.Description != "" {
+			description = meta.Description
+		}
+		if meta.Resolution != "" {
+			resolution = meta.Resolution
+		}
+		if len(meta.References) > 0 {
+			references = meta.References
+		}
+		for _, tag := range []string{meta.Provider, meta.Service} {
+			if tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	helpURI := misconfig.PrimaryURL
+	if len(references) > 0 {
+		helpURI = references[0]
+	}
+
+	return sarifRule{
+		ID:                   misconfig.ID,
+		Name:                 misconfig.AVDID,
+		ShortDescription:     sarifMessage{Text: title},
+		FullDescription:      sarifMessage{Text: description},
+		Help:                 newSarifHelp(misconfig, description, resolution, references),
+		HelpURI:              helpURI,
+		DefaultConfiguration: sarifConfiguration{Level: sarifLevel(misconfig.Severity)},
+		Properties: sarifProperties{
+			Tags:             tags,
+			Precision:        "very-high",
+			SecuritySeverity: securitySeverity(misconfig.Severity),
+		},
+	}
+}
+
+// newSarifHelp는 rule 도움말을 텍스트와 마크다운으로 구성합니다
+func newSarifHelp(misconfig types.Misconfiguration, description, resolution string, references []string) sarifHelp {
+	text := []string{"Policy: " + misconfig.ID, "Severity: " + misconfig.Severity, description}
+	markdown := []string{
+		"| Policy | Severity |",
+		"| --- | --- |",
+		"| " + misconfig.ID + " | " + misconfig.Severity + " |",
+		"",
+		description,
+	}
+
+	if resolution != "" {
+		text = append(text, "Resolution: "+resolution)
+		markdown = append(markdown, "", "**Resolution:** "+resolution)
+	}
+
+	if len(references) > 0 {
+		text = append(text, "References: "+strings.Join(references, ", "))
+		markdown = append(markdown, "", "**References:**")
+		for _, ref := range references {
+			markdown = append(markdown, "- "+ref)
+		}
+	}
+
+	return sarifHelp{
+		Text:     strings.Join(text, "\n"),
+		Markdown: strings.Join(markdown, "\n"),
+	}
+}
+
+// newSarifResult는 misconfiguration을 result로 변환합니다
+func newSarifResult(target string, misconfig types.Misconfiguration, ruleIndex int) sarifResult {
+	location := sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: target},
+		},
+	}
+
+	if cause := misconfig.CauseMetadata; cause != nil {
+		if cause.StartLine > 0 {
+			endLine := cause.EndLine
+			if endLine < cause.StartLine {
+				endLine = cause.StartLine
+			}
+			location.PhysicalLocation.Region = &sarifRegion{StartLine: cause.StartLine, EndLine: endLine}
+		}
+		if cause.Resource != "" {
+			location.LogicalLocations = []sarifLogical{{FullyQualifiedName: cause.Resource, Kind: "resource"}}
+		}
+	}
+
+	result := sarifResult{
+		RuleID:    misconfig.ID,
+		RuleIndex: ruleIndex,
+		Level:     sarifLevel(misconfig.Severity),
+		Message:   sarifMessage{Text: misconfig.Message},
+		Locations: []sarifLocation{location},
+	}
+
+	if misconfig.Fingerprint != "" {
+		result.PartialFingerprints = map[string]string{"misconfigFingerprint/v1": misconfig.Fingerprint}
+	}
+
+	return result
+}
+
+// sarifLevel은 심각도를 SARIF level로 변환합니다
+func sarifLevel(severity string) string {
+	switch strings.ToUpper(severity) {
+	case types.SeverityCritical, types.SeverityHigh:
+		return "error"
+	case types.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// securitySeverity는 심각도를 코드 스캐닝 대시보드의 점수 (0.0 ~ 10.0) 로 변환합니다
+func securitySeverity(severity string) string {
+	switch strings.ToUpper(severity) {
+	case types.SeverityCritical:
+		return "9.5"
+	case types.SeverityHigh:
+		return "8.0"
+	case types.SeverityMedium:
+		return "5.5"
+	case types.SeverityLow:
+		return "2.0"
+	default:
+		return "0.0"
+	}
+}
@@ -0,0 +1,124 @@
+package report
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestSarifRender(t *testing.T) {
+	out := render(t, "sarif", testResults(), Options{
+		Policies: map[string]*types.PolicyMetadata{
+			"TEST-001": {Title: "S3 bucket encryption", Provider: "AWS", Service: "S3"},
+		},
+	})
+
+	var log sarifLog
+	if err := json.Unmarshal(out, &log); err != nil {
+		t.Fatalf("invalid SARIF: %v", err)
+	}
+	if log.Version != sarifVersion || len(log.Runs) != 1 {
+		t.Fatalf("version = %q, runs = %d, want %q, 1", log.Version, len(log.Runs), sarifVersion)
+	}
+	run := log.Runs[0]
+
+	// 정책마다 rule 하나, 처음 실패한 순서대로
+	type rule struct{ ID, Title, Level, Score string }
+	var rules []rule
+	for _, r := range run.Tool.Driver.Rules {
+		rules = append(rules, rule{r.ID, r.ShortDescription.Text, r.DefaultConfiguration.Level, r.Properties.SecuritySeverity})
+	}
+	wantRules := []rule{
+		{"TEST-002", "Versioning", "warning", "5.5"},
+		{"TEST-001", "S3 bucket encryption", "error", "8.0"},
+	}
+	if !reflect.DeepEqual(rules, wantRules) {
+		t.Errorf("rules = %+v, want %+v", rules, wantRules)
+	}
+	if tags := run.Tool.Driver.Rules[1].Properties.Tags; !reflect.DeepEqual(tags, []string{"security", "terraform", "AWS", "S3"}) {
+		t.Errorf("rule tags = %v", tags)
+	}
+
+	// PASS 항목과 기준선 항목은 result가 아님
+	tests := []struct {
+		ruleID    string
+		ruleIndex int
+		level     string
+		region    *sarifRegion
+		resource  string
+	}{
+		{ruleID: "TEST-002", ruleIndex: 0, level: "warning"},
+		{ruleID: "TEST-001", ruleIndex: 1, level: "error", region: &sarifRegion{StartLine: 3, EndLine: 7}, resource: "aws_s3_bucket.logs"},
+		{ruleID: "TEST-001", ruleIndex: 1, level: "error", region: &sarifRegion{StartLine: 10, EndLine: 10}, resource: "aws_s3_bucket.tmp"},
+	}
+	if len(run.Results) != len(tests) {
+		t.Fatalf("results = %d, want %d", len(run.Results), len(tests))
+	}
+	for i, tt := range tests {
+		result := run.Results[i]
+		location := result.Locations[0]
+		if result.RuleID != tt.ruleID || result.RuleIndex != tt.ruleIndex || result.Level != tt.level {
+			t.Errorf("result %d = %s/%d/%s, want %s/%d/%s", i, result.RuleID, result.RuleIndex, result.Level, tt.ruleID, tt.ruleIndex, tt.level)
+		}
+		if location.PhysicalLocation.ArtifactLocation.URI != "main.tf" {
+			t.Errorf("result %d uri = %q, want main.tf", i, location.PhysicalLocation.ArtifactLocation.URI)
+		}
+		if !reflect.DeepEqual(location.PhysicalLocation.Region, tt.region) {
+			t.Errorf("result %d region = %+v, want %+v", i, location.PhysicalLocation.Region, tt.region)
+		}
+		var resource string
+		if len(location.LogicalLocations) > 0 {
+			resource = location.LogicalLocations[0].FullyQualifiedName
+		}
+		if resource != tt.resource {
+			t.Errorf("result %d resource = %q, want %q", i, resource, tt.resource)
+		}
+		if result.PartialFingerprints["misconfigFingerprint/v1"] == "" {
+			t.Errorf("result %d has no fingerprint", i)
+		}
+	}
+}
+
+func TestSarifRenderEmpty(t *testing.T) {
+	out := render(t, "sarif", []*types.ScanResult{{ArtifactName: "infra"}}, Options{})
+
+	// 결과가 없어도 rules, results는 빈 배열
+	var log map[string]interface{}
+	if err := json.Unmarshal(out, &log); err != nil {
+		t.Fatalf("invalid SARIF: %v", err)
+	}
+	run := log["runs"].([]interface{})[0].(map[string]interface{})
+	if results, ok := run["results"].([]interface{}); !ok || len(results) != 0 {
+		t.Errorf("results = %v, want []", run["results"])
+	}
+	rules := run["tool"].(map[string]interface{})["driver"].(map[string]interface{})["rules"]
+	if rules, ok := rules.([]interface{}); !ok || len(rules) != 0 {
+		t.Errorf("rules = %v, want []", rules)
+	}
+}
+
+func TestSarifLevel(t *testing.T) {
+	tests := []struct {
+		severity string
+		level    string
+		score    string
+	}{
+		{types.SeverityCritical, "error", "9.5"},
+		{types.SeverityHigh, "error", "8.0"},
+		{"medium", "warning", "5.5"},
+		{types.SeverityLow, "note", "2.0"},
+		{types.SeverityUnknown, "note", "0.0"},
+		{"", "note", "0.0"},
+	}
+
+	for _, tt := range tests {
+		if got := sarifLevel(tt.severity); got != tt.level {
+			t.Errorf("sarifLevel(%q) = %q, want %q", tt.severity, got, tt.level)
+		}
+		if got := securitySeverity(tt.severity); got != tt.score {
+			t.Errorf("securitySeverity(%q) = %q, want %q", tt.severity, got, tt.score)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// ANSI 색상 코드
+const (
+	colorReset   = "\x1b[0m"
+	colorRed     = "\x1b[31m"
+	colorBoldRed = "\x1b[1;31m"
+	colorYellow  = "\x1b[33m"
+	colorBlue    = "\x1b[34m"
+)
+
+// maxCellWidth는 표 셀의 최대 너비입니다 (넘으면 말줄임)
+const maxCellWidth = 60
+
+// tableRenderer는 터미널에서 읽기 위한 표 형식으로 출력합니다
+// 파일별로 요약 수와 실패 항목 표를 출력하고, 마지막에 전체 요약을 출력합니다
+type tableRenderer struct{}
+
+func (tableRenderer) Render(w io.Writer, results []*types.ScanResult, opts Options) error {
+	out := bufio.NewWriter(w)
+	var all []types.Misconfiguration
+	targets := 0
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			failed := failures(result)
+			all = append(all, failed...)
+			targets++
+
+			writeTableHeader(out, result, failed, opts.Color)
+			if len(failed) > 0 {
+				writeTable(out, failed, opts.Color)
+			}
+			fmt.Fprintln(out)
+		}
+	}
+
+	fmt.Fprintf(out, "Total: %d targets, %s\n", targets, severitySummary(len(all), countSeverities(all), opts.Color))
+	return out.Flush()
+}
+
+func (tableRenderer) ContentType() string { return "text/plain; charset=utf-8" }
+
+func (tableRenderer) Extension() string { return "txt" }
+
+// writeTableHeader는 대상 이름과 통과/실패/심각도별 개수를 출력합니다
+func writeTableHeader(w io.Writer, result types.Result, failed []types.Misconfiguration, color bool) {
+	title := fmt.Sprintf("%s (%s)", result.Target, result.Type)
+	fmt.Fprintln(w, title)
+	fmt.Fprintln(w, strings.Repeat("=", utf8.RuneCountInString(title)))
+
+	if summary := result.MisconfSummary; summary != nil {
+		tests := fmt.Sprintf("Tests: %d (SUCCESSES: %d, FAILURES: %d", summary.Successes+summary.Failures, summary.Successes, summary.Failures)
+		if summary.Baselined > 0 {
+			tests += fmt.Sprintf(", BASELINED: %d", summary.Baselined)
+		}
+		fmt.Fprintln(w, tests+")")
+	}
+	fmt.Fprintln(w, severitySummary(len(failed), countSeverities(failed), color))
+}
+
+// severitySummary는 "Failures: N (CRITICAL: n, ...)" 형태의 요약을 만듭니다
+func severitySummary(total int, counts map[string]int, color bool) string {
+	var parts []string
+	for _, severity := range severitiesDesc() {
+		parts = append(parts, fmt.Sprintf("%s: %d", colorize(severity, severity, color), counts[severity]))
+	}
+	return fmt.Sprintf("Failures: %d (%s)", total, strings.Join(parts, ", "))
+}
+
+// writeTable은 실패 항목을 표로 출력합니다
+func writeTable(w io.Writer, misconfigs []types.Misconfiguration, color bool) {
+	header := []string{"SEVERITY", "ID", "RESOURCE", "LINE", "MESSAGE"}
+	rows := make([][]string, 0, len(misconfigs))
+	for _, misconfig := range misconfigs {
+		resource, line := "", ""
+		if cause := misconfig.CauseMetadata; cause != nil {
+			resource = cause.Resource
+			if cause.StartLine > 0 {
+				line = fmt.Sprintf("%d", cause.StartLine)
+				if cause.EndLine > cause.StartLine {
+					line = fmt.Sprintf("%d-%d", cause.StartLine, cause.EndLine)
+				}
+			}
+		}
+		rows = append(rows, []string{misconfig.Severity, misconfig.ID, resource, line, misconfig.Message})
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			if width := utf8.RuneCountInString(truncate(cell)); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	border := func(left, mid, right string) {
+		parts := make([]string, len(widths))
+		for i, width := range widths {
+			parts[i] = strings.Repeat("─", width+2)
+		}
+		fmt.Fprintln(w, left+strings.Join(parts, mid)+right)
+	}
+	writeRow := func(row []string, colored bool) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cell = truncate(cell)
+			padding := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+			if i == 0 && colored {
+				cell = colorize(cell, cell, color)
+			}
+			cells[i] = " " + cell + padding + " "
+		}
+		fmt.Fprintln(w, "│"+strings.Join(cells, "│")+"│")
+	}
+
+	border("┌", "┬", "┐")
+	writeRow(header, false)
+	border("├", "┼", "┤")
+	for _, row := range rows {
+		writeRow(row, true)
+	}
+	border("└", "┴", "┘")
+}
+
+// truncate는 셀 내용을 한 줄로 만들고 최대 너비로 자릅니다
+func truncate(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if utf8.RuneCountInString(value) <= maxCellWidth {
+		return value
+	}
+	runes := []rune(value)
+	return string(runes[:maxCellWidth-1]) + "…"
+}
+
+// colorize는 심각도에 맞는 색상으로 텍스트를 감쌉니다
+func colorize(text, severity string, enabled bool) string {
+	if !enabled {
+		return text
+	}
+
+	var code string
+	switch strings.ToUpper(severity) {
+	case types.SeverityCritical:
+		code = colorBoldRed
+	case types.SeverityHigh:
+		code = colorRed
+	case types.SeverityMedium:
+		code = colorYellow
+	case types.SeverityLow:
+		code = colorBlue
+	default:
+		return text
+	}
+	return code + text + colorReset
+}
@@ -0,0 +1,74 @@
+package report
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableRender(t *testing.T) {
+	tests := []struct {
+		name  string
+		color bool
+		want  []string
+	}{
+		{
+			name: "plain",
+			want: []string{
+				"main.tf (terraform)\n===================\n",
+				"Tests: 4 (SUCCESSES: 1, FAILURES: 3, BASELINED: 1)",
+				"Failures: 3 (CRITICAL: 0, HIGH: 2, MEDIUM: 1, LOW: 0, UNKNOWN: 0)",
+				"│ HIGH     │ TEST-001 │ aws_s3_bucket.logs │ 3-7  │ bucket logs is not encrypted  │",
+				"│ MEDIUM   │ TEST-002 │                    │      │ bucket logs has no versioning │",
+				"empty.tf (terraform)",
+				"Total: 2 targets, Failures: 3 (",
+			},
+		},
+		{
+			name:  "color",
+			color: true,
+			want: []string{
+				"│ " + colorRed + "HIGH" + colorReset + "     │",
+				"│ " + colorYellow + "MEDIUM" + colorReset + "   │",
+				colorBoldRed + "CRITICAL" + colorReset + ": 0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := string(render(t, "table", testResults(), Options{Color: tt.color}))
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output does not contain %q:\n%s", want, out)
+				}
+			}
+			if !tt.color && strings.Contains(out, "\x1b[") {
+				t.Errorf("plain output contains ANSI escape codes:\n%s", out)
+			}
+			// PASS 항목은 표에 없음
+			if strings.Contains(out, "TEST-003") {
+				t.Errorf("output contains passed policy TEST-003:\n%s", out)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	long := strings.Repeat("a", maxCellWidth+10)
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"short", "short"},
+		{"multi\nline\tvalue", "multi line value"},
+		{long, strings.Repeat("a", maxCellWidth-1) + "…"},
+		{strings.Repeat("가", maxCellWidth), strings.Repeat("가", maxCellWidth)},
+	}
+
+	for _, tt := range tests {
+		if got := truncate(tt.value); got != tt.want {
+			t.Errorf("truncate(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// FormatTemplate은 Go text/template 기반 사용자 정의 형식입니다
+const FormatTemplate = "template"
+
+// templateRenderer는 요청에 지정된 Go 템플릿으로 출력합니다
+// 템플릿에는 스캔 결과가 하나의 ScanResult로 전달됩니다 (여러 파일이면 Results를 합침)
+type templateRenderer struct{}
+
+func (templateRenderer) Render(w io.Writer, results []*types.ScanResult, opts Options) error {
+	if opts.Template == "" {
+		return fmt.Errorf("template is required for format %s", FormatTemplate)
+	}
+
+	tmpl, err := ParseTemplate(opts.Template)
+	if err != nil {
+		return err
+	}
+
+	if err := tmpl.Execute(w, mergeResults(results, opts.ArtifactName)); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return nil
+}
+
+func (templateRenderer) ContentType() string { return "text/plain; charset=utf-8" }
+
+func (templateRenderer) Extension() string { return "txt" }
+
+// ParseTemplate은 템플릿을 헬퍼 함수와 함께 파싱합니다
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// mergeResults는 여러 스캔 결과를 하나의 ScanResult로 합칩니다
+func mergeResults(results []*types.ScanResult, artifactName string) *types.ScanResult {
+	if len(results) == 1 {
+		return results[0]
+	}
+
+	merged := &types.ScanResult{
+		SchemaVersion: 2,
+		CreatedAt:     time.Now(),
+		ArtifactName:  artifactName,
+		ArtifactType:  "terraform",
+	}
+	for _, result := range results {
+		merged.Results = append(merged.Results, result.Results...)
+		merged.Profile = result.Profile
+		merged.PolicyFilter = result.PolicyFilter
+	}
+	return merged
+}
+
+// 템플릿 헬퍼 함수
+var templateFuncs = template.FuncMap{
+	// 심각도
+	"severities":     severitiesDesc,
+	"severityCounts": severityCounts,
+	"failures":       templateFailures,
+	"countFailures":  func(v interface{}) int { return len(misconfigsOf(v)) },
+
+	// 이스케이프
+	"escapeXML":  escapeXML,
+	"escapeHTML": html.EscapeString,
+	"escapeCSV":  escapeCSV,
+	"toJSON":     toJSON,
+
+	// 문자열
+	"lower":    strings.ToLower,
+	"upper":    strings.ToUpper,
+	"trim":     strings.TrimSpace,
+	"join":     strings.Join,
+	"contains": strings.Contains,
+	"replace":  strings.ReplaceAll,
+	"now":      time.Now,
+}
+
+// misconfigsOf는 ScanResult, Result 또는 misconfiguration 목록에서 실패 항목을 모읍니다
+func misconfigsOf(v interface{}) []types.Misconfiguration {
+	var misconfigs []types.Misconfiguration
+
+	switch value := v.(type) {
+	case *types.ScanResult:
+		for _, result := range value.Results {
+			misconfigs = append(misconfigs, failures(result)...)
+		}
+	case types.ScanResult:
+		return misconfigsOf(&value)
+	case types.Result:
+		misconfigs = failures(value)
+	case *types.Result:
+		misconfigs = failures(*value)
+	case []types.Misconfiguration:
+		misconfigs = failures(types.Result{Misconfigurations: value})
+	}
+
+	return misconfigs
+}
+
+// severityCounts는 실패 항목의 심각도별 개수를 반환합니다
+func severityCounts(v interface{}) map[string]int {
+	return countSeverities(misconfigsOf(v))
+}
+
+// templateFailures는 PASS 항목을 제외한 실패 항목을 심각도가 높은 순으로 반환합니다
+func templateFailures(v interface{}) []types.Misconfiguration {
+	return misconfigsOf(v)
+}
+
+// escapeXML은 XML 텍스트와 속성 값에 안전하게 이스케이프합니다
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// escapeCSV는 CSV 필드 하나로 사용할 수 있게 필요한 경우 따옴표로 감쌉니다
+func escapeCSV(s string) string {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	_ = writer.Write([]string{s})
+	writer.Flush()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// toJSON은 값을 JSON 문자열로 변환합니다
+func toJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// 서버에 등록된 이름 있는 템플릿 (@이름으로 참조)
+var (
+	templatesMu sync.RWMutex
+	templates   = make(map[string]string)
+)
+
+// LoadTemplates는 디렉토리의 템플릿 파일 (.tpl, .tmpl) 을 이름 있는 템플릿으로 등록합니다
+// 이름은 확장자를 뺀 파일명이며, 디렉토리가 없으면 아무것도 등록하지 않습니다
+func LoadTemplates(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read template directory: %w", err)
+	}
+
+	loaded := make(map[string]string)
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".tpl" && ext != ".tmpl") {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+		if _, err := ParseTemplate(string(content)); err != nil {
+			return fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		loaded[strings.TrimSuffix(entry.Name(), ext)] = string(content)
+	}
+
+	templatesMu.Lock()
+	defer templatesMu.Unlock()
+	templates = loaded
+	return nil
+}
+
+// ResolveTemplate은 템플릿 값을 실제 템플릿 내용으로 변환합니다
+// "@이름"이면 등록된 템플릿을, 그 외에는 값 자체를 템플릿으로 사용합니다
+func ResolveTemplate(value string) (string, error) {
+	if !strings.HasPrefix(value, "@") {
+		return value, nil
+	}
+
+	name := strings.TrimPrefix(value, "@")
+	templatesMu.RLock()
+	defer templatesMu.RUnlock()
+
+	text, ok := templates[name]
+	if !ok {
+		return "", fmt.Errorf("unknown template: %s (available: %s)", name, strings.Join(templateNames(), ", "))
+	}
+	return text, nil
+}
+
+// TemplateNames는 등록된 템플릿 이름을 정렬해 반환합니다
+func TemplateNames() []string {
+	templatesMu.RLock()
+	defer templatesMu.RUnlock()
+	return templateNames()
+}
+
+func templateNames() []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
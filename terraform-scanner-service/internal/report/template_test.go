@@ -0,0 +1,164 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestTemplateEscaping(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		value    string
+		want     string
+	}{
+		{name: "xml", template: `{{ escapeXML . }}`, value: `<a href="x">&'`, want: "&lt;a href=&#34;x&#34;&gt;&amp;&#39;"},
+		{name: "html", template: `{{ escapeHTML . }}`, value: `<script>alert("x")</script>`, want: "&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;"},
+		{name: "csv plain", template: `{{ escapeCSV . }}`, value: "plain", want: "plain"},
+		{name: "csv comma", template: `{{ escapeCSV . }}`, value: "a,b", want: `"a,b"`},
+		{name: "csv quote", template: `{{ escapeCSV . }}`, value: `say "hi"`, want: `"say ""hi"""`},
+		{name: "csv newline", template: `{{ escapeCSV . }}`, value: "a\nb", want: "\"a\nb\""},
+		{name: "json", template: `{{ toJSON . }}`, value: "a\"b</script>", want: `"a\"b\u003c/script\u003e"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("ParseTemplate() error = %v", err)
+			}
+			var out strings.Builder
+			if err := tmpl.Execute(&out, tt.value); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("%s = %q, want %q", tt.template, out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		results  []*types.ScanResult
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "failures",
+			template: `{{ range failures . }}{{ .ID }}:{{ .Severity }} {{ end }}`,
+			results:  testResults(),
+			want:     "TEST-001:HIGH TEST-001:HIGH TEST-002:MEDIUM ",
+		},
+		{
+			name:     "severity counts",
+			template: `{{ $counts := severityCounts . }}{{ range severities }}{{ . }}={{ index $counts . }} {{ end }}total={{ countFailures . }}`,
+			results:  testResults(),
+			want:     "CRITICAL=0 HIGH=2 MEDIUM=1 LOW=0 UNKNOWN=0 total=3",
+		},
+		{
+			name:     "per target",
+			template: `{{ range .Results }}{{ .Target }}={{ countFailures . }};{{ end }}`,
+			results:  testResults(),
+			want:     "main.tf=3;empty.tf=0;",
+		},
+		{
+			name:     "merged results",
+			template: `{{ .ArtifactName }}:{{ len .Results }}`,
+			results:  append(testResults(), testResults()...),
+			want:     "repo:4",
+		},
+		{
+			name:    "missing template",
+			results: testResults(),
+			wantErr: true,
+		},
+		{
+			name:     "parse error",
+			template: `{{ range }}`,
+			results:  testResults(),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			err := templateRenderer{}.Render(&out, tt.results, Options{Template: tt.template, ArtifactName: "repo"})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Render() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("Render() = %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadTemplates(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"summary.tpl":  `{{ countFailures . }}`,
+		"csv.tmpl":     `{{ range failures . }}{{ escapeCSV .Message }}{{ end }}`,
+		"notes.txt":    `ignored`,
+		"broken.tpl~":  `{{ range }}`,
+		"sub/skip.tpl": `ignored`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := LoadTemplates(dir); err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+	t.Cleanup(func() { LoadTemplates(t.TempDir()) })
+
+	if names := strings.Join(TemplateNames(), ","); names != "csv,summary" {
+		t.Errorf("TemplateNames() = %s, want csv,summary", names)
+	}
+
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "@summary", want: files["summary.tpl"]},
+		{value: "{{ .ArtifactName }}", want: "{{ .ArtifactName }}"},
+		{value: "@missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ResolveTemplate(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ResolveTemplate(%q) = %q, %v, want %q, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	// 파싱할 수 없는 템플릿이 있으면 에러, 기존 등록은 유지
+	if err := os.WriteFile(filepath.Join(dir, "broken.tpl"), []byte(`{{ range }}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadTemplates(dir); err == nil {
+		t.Error("LoadTemplates() error = nil, want error for broken.tpl")
+	}
+	if names := strings.Join(TemplateNames(), ","); names != "csv,summary" {
+		t.Errorf("TemplateNames() after failed load = %s, want csv,summary", names)
+	}
+}
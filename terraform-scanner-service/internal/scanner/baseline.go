@@ -0,0 +1,90 @@
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// BaselineVersion은 기준선 파일 형식 버전입니다
+const BaselineVersion = 1
+
+// NewBaseline은 스캔 결과의 실패 항목으로 기준선을 생성합니다
+func NewBaseline(results []*types.ScanResult) *types.Baseline {
+	baseline := &types.Baseline{
+		Version:   BaselineVersion,
+		CreatedAt: time.Now(),
+		Findings:  []types.BaselineEntry{},
+	}
+
+	for _, finding := range collectFindings(results) {
+		entry := types.BaselineEntry{
+			Fingerprint: finding.Fingerprint,
+			ID:          finding.ID,
+			Target:      finding.Target,
+			Severity:    finding.Severity,
+		}
+		if finding.CauseMetadata != nil {
+			entry.Resource = finding.CauseMetadata.Resource
+		}
+		baseline.Findings = append(baseline.Findings, entry)
+	}
+
+	return baseline
+}
+
+// ParseBaseline은 기준선 JSON을 파싱하고 검증합니다
+func ParseBaseline(data []byte) (*types.Baseline, error) {
+	baseline := &types.Baseline{}
+	if err := json.Unmarshal(data, baseline); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline: %w", err)
+	}
+
+	if err := ValidateBaseline(baseline); err != nil {
+		return nil, err
+	}
+
+	return baseline, nil
+}
+
+// ValidateBaseline은 기준선의 버전과 fingerprint를 검증합니다
+func ValidateBaseline(baseline *types.Baseline) error {
+	if baseline.Version != BaselineVersion {
+		return fmt.Errorf("unsupported baseline version: %d", baseline.Version)
+	}
+
+	for i, entry := range baseline.Findings {
+		if entry.Fingerprint == "" {
+			return fmt.Errorf("baseline finding %d: fingerprint is required", i)
+		}
+	}
+
+	return nil
+}
+
+// applyBaseline은 기준선에 있는 실패 항목을 분리합니다
+// 같은 fingerprint는 기준선에 있는 개수만큼만 분리합니다 (PASS 항목은 그대로 유지)
+func applyBaseline(misconfigs []types.Misconfiguration, baseline *types.Baseline) ([]types.Misconfiguration, []types.Misconfiguration) {
+	if baseline == nil || len(baseline.Findings) == 0 {
+		return misconfigs, nil
+	}
+
+	counts := make(map[string]int, len(baseline.Findings))
+	for _, entry := range baseline.Findings {
+		counts[entry.Fingerprint]++
+	}
+
+	var reported, baselined []types.Misconfiguration
+	for _, misconfig := range misconfigs {
+		if misconfig.Status != "PASS" && counts[misconfig.Fingerprint] > 0 {
+			counts[misconfig.Fingerprint]--
+			baselined = append(baselined, misconfig)
+			continue
+		}
+		reported = append(reported, misconfig)
+	}
+
+	return reported, baselined
+}
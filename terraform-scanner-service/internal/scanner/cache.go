@@ -0,0 +1,141 @@
+package scanner
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// ResultCache는 파일별 스캔 결과를 내용 해시로 보관하는 LRU 캐시입니다
+// 결과는 JSON으로 직렬화해 보관하므로 꺼낸 결과를 수정해도 캐시에 영향이 없습니다
+type ResultCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List // 앞쪽이 최근 사용 항목
+	hits       uint64
+	misses     uint64
+}
+
+// cacheEntry는 캐시에 보관된 결과입니다
+type cacheEntry struct {
+	key    string
+	result []byte
+}
+
+// CacheStats는 결과 캐시의 사용 현황입니다
+type CacheStats struct {
+	Enabled    bool   `json:"enabled"`
+	Hits       uint64 `json:"hits"`
+	Misses     uint64 `json:"misses"`
+	Entries    int    `json:"entries"`
+	MaxEntries int    `json:"max_entries"`
+}
+
+// NewResultCache는 최대 maxEntries개의 결과를 보관하는 캐시를 생성합니다
+func NewResultCache(maxEntries int) *ResultCache {
+	return &ResultCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get은 키에 해당하는 결과를 반환하고 적중/실패 수를 기록합니다
+func (c *ResultCache) Get(key string) (*types.Result, bool) {
+	c.mu.Lock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		c.mu.Unlock()
+		return nil, false
+	}
+	c.hits++
+	c.order.MoveToFront(elem)
+	content := elem.Value.(*cacheEntry).result
+	c.mu.Unlock()
+
+	var result types.Result
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, false
+	}
+	return &result, true
+}
+
+// Put은 결과를 캐시에 저장하고, 가득 차면 가장 오래 사용하지 않은 항목을 제거합니다
+func (c *ResultCache) Put(key string, result *types.Result) {
+	content, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*cacheEntry).result = content
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, result: content})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// Purge는 모든 결과를 제거하고 제거된 항목 수를 반환합니다 (적중/실패 수는 유지)
+func (c *ResultCache) Purge() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := c.order.Len()
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+	return removed
+}
+
+// Stats는 캐시의 현재 사용 현황을 반환합니다
+func (c *ResultCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{
+		Enabled:    true,
+		Hits:       c.hits,
+		Misses:     c.misses,
+		Entries:    c.order.Len(),
+		MaxEntries: c.maxEntries,
+	}
+}
+
+// resultCacheKey는 파일 결과의 캐시 키를 계산합니다
+// 변수는 파일 내용에서 해석되므로 내용 해시에 포함되며, 정책 리비전과 결과에 영향을 주는
+// 스캔 옵션 (필터, 심각도, 무시 규칙, 기준선 등) 과 대상 경로 (지문, 무시 규칙) 를 함께 사용합니다
+func resultCacheKey(revision string, opts ScanOptions, target string, content []byte) (string, error) {
+	// 결과에 영향을 주지 않는 옵션은 제외 (탐색 범위, 아티팩트 이름, 진행 콜백)
+	opts.ArtifactName = ""
+	opts.SkipDirs = nil
+	opts.SkipFiles = nil
+	opts.Modules = nil
+	opts.Progress = nil
+
+	encoded, err := json.Marshal(opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode scan options: %w", err)
+	}
+
+	h := sha256.New()
+	for _, part := range [][]byte{[]byte(revision), encoded, []byte(target), content} {
+		fmt.Fprintf(h, "%d:", len(part))
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,120 @@
+package scanner
+
+import (
+	"testing"
+
+	"terraform-scanner-service/internal/types"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	const (
+		revision = "0123456789ab"
+		target   = "envs/prod/main.tf"
+	)
+	content := []byte(bareBucket)
+	base := ScanOptions{Environment: "prod"}
+
+	baseKey, err := resultCacheKey(revision, base, target, content)
+	if err != nil {
+		t.Fatalf("resultCacheKey() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		revision string
+		opts     *ScanOptions
+		target   string
+		content  []byte
+		same     bool
+	}{
+		{
+			name: "same inputs",
+			same: true,
+		},
+		{
+			name: "scan scope options",
+			opts: &ScanOptions{
+				Environment:  "prod",
+				ArtifactName: "repo",
+				SkipDirs:     []string{"modules"},
+				SkipFiles:    []string{"*.tfvars"},
+				Modules:      []string{"envs/prod"},
+				Progress:     func(done, total int) {},
+			},
+			same: true,
+		},
+		{
+			name:     "policy revision",
+			revision: "ba9876543210",
+		},
+		{
+			name:   "target path",
+			target: "envs/dev/main.tf",
+		},
+		{
+			name:    "file content",
+			content: []byte(compliantBucket),
+		},
+		{
+			name: "environment",
+			opts: &ScanOptions{Environment: "dev"},
+		},
+		{
+			name: "filter",
+			opts: &ScanOptions{Environment: "prod", Filter: &types.PolicyFilter{MinSeverity: types.SeverityHigh}},
+		},
+		{
+			name: "include passes",
+			opts: &ScanOptions{Environment: "prod", IncludePasses: true},
+		},
+		{
+			name: "ignore rules",
+			opts: &ScanOptions{Environment: "prod", Ignore: []types.IgnoreRule{{ID: "TEST-S3-001"}}},
+		},
+		{
+			name: "severity overrides",
+			opts: &ScanOptions{Environment: "prod", SeverityOverrides: []types.SeverityOverride{{ID: "TEST-*", Severity: types.SeverityLow}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision, opts, target, content := revision, base, target, content
+			if tt.revision != "" {
+				revision = tt.revision
+			}
+			if tt.opts != nil {
+				opts = *tt.opts
+			}
+			if tt.target != "" {
+				target = tt.target
+			}
+			if tt.content != nil {
+				content = tt.content
+			}
+
+			key, err := resultCacheKey(revision, opts, target, content)
+			if err != nil {
+				t.Fatalf("resultCacheKey() error = %v", err)
+			}
+			if same := key == baseKey; same != tt.same {
+				t.Errorf("resultCacheKey() same as base = %v, want %v", same, tt.same)
+			}
+		})
+	}
+}
+
+func TestResultCacheKeyBoundaries(t *testing.T) {
+	// 각 부분의 길이를 함께 해시하므로 경계가 달라지면 다른 키
+	a, err := resultCacheKey("abc", ScanOptions{}, "main.tf", []byte("x"))
+	if err != nil {
+		t.Fatalf("resultCacheKey() error = %v", err)
+	}
+	b, err := resultCacheKey("ab", ScanOptions{}, "cmain.tf", []byte("x"))
+	if err != nil {
+		t.Fatalf("resultCacheKey() error = %v", err)
+	}
+	if a == b {
+		t.Errorf("resultCacheKey() collided for different part boundaries: %s", a)
+	}
+}
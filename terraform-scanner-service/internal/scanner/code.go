@@ -0,0 +1,54 @@
+package scanner
+
+import (
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// maxCodeLines는 코드 스니펫에 포함할 최대 라인 수입니다
+const maxCodeLines = 10
+
+// attachCode는 원인 위치의 소스 코드를 CauseMetadata.Code에 기록합니다
+// 범위가 maxCodeLines를 넘으면 잘라내고 마지막 라인에 Truncated를 표시합니다
+func attachCode(content []byte, misconfigs []types.Misconfiguration) {
+	if len(content) == 0 {
+		return
+	}
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+
+	for i := range misconfigs {
+		cause := misconfigs[i].CauseMetadata
+		if cause == nil || cause.StartLine <= 0 || cause.StartLine > len(lines) {
+			continue
+		}
+
+		endLine := cause.EndLine
+		if endLine < cause.StartLine {
+			endLine = cause.StartLine
+		}
+		if endLine > len(lines) {
+			endLine = len(lines)
+		}
+
+		truncated := false
+		if endLine-cause.StartLine+1 > maxCodeLines {
+			endLine = cause.StartLine + maxCodeLines - 1
+			truncated = true
+		}
+
+		code := &types.CodeLines{}
+		for number := cause.StartLine; number <= endLine; number++ {
+			code.Lines = append(code.Lines, types.CodeLine{
+				Number:     number,
+				Content:    lines[number-1],
+				IsCause:    true,
+				FirstCause: number == cause.StartLine,
+				LastCause:  number == endLine,
+			})
+		}
+		code.Lines[len(code.Lines)-1].Truncated = truncated
+
+		cause.Code = code
+	}
+}
@@ -0,0 +1,98 @@
+package scanner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// Fingerprint는 라인 번호와 무관하게 동일한 문제를 식별하는 값을 계산합니다
+// 정책 ID + 리소스 주소 + 대상 파일로 구성되며, 리소스가 없으면 메시지를 사용합니다
+func Fingerprint(target string, misconfig types.Misconfiguration) string {
+	id := misconfig.ID
+	if id == "" {
+		id = misconfig.AVDID
+	}
+
+	location := misconfig.Message
+	if misconfig.CauseMetadata != nil && misconfig.CauseMetadata.Resource != "" {
+		location = misconfig.CauseMetadata.Resource
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{strings.ToUpper(id), location, target}, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// setFingerprints는 misconfiguration에 fingerprint를 기록합니다
+func setFingerprints(target string, misconfigs []types.Misconfiguration) {
+	for i := range misconfigs {
+		misconfigs[i].Fingerprint = Fingerprint(target, misconfigs[i])
+	}
+}
+
+// Compare는 base 대비 head 스캔에서 새로 발견된, 해결된, 유지된 문제를 분류합니다
+// 같은 fingerprint가 여러 번 나타나면 개수 단위로 대응시킵니다 (PASS 항목은 제외)
+func Compare(base, head []*types.ScanResult) *types.Comparison {
+	comparison := &types.Comparison{
+		New:        []types.Finding{},
+		Resolved:   []types.Finding{},
+		Persisting: []types.Finding{},
+	}
+
+	remaining := make(map[string][]types.Finding)
+	var order []string
+	for _, finding := range collectFindings(base) {
+		if _, ok := remaining[finding.Fingerprint]; !ok {
+			order = append(order, finding.Fingerprint)
+		}
+		remaining[finding.Fingerprint] = append(remaining[finding.Fingerprint], finding)
+	}
+
+	for _, finding := range collectFindings(head) {
+		if matched := remaining[finding.Fingerprint]; len(matched) > 0 {
+			remaining[finding.Fingerprint] = matched[1:]
+			comparison.Persisting = append(comparison.Persisting, finding)
+			continue
+		}
+		comparison.New = append(comparison.New, finding)
+	}
+
+	for _, fingerprint := range order {
+		comparison.Resolved = append(comparison.Resolved, remaining[fingerprint]...)
+	}
+
+	comparison.Summary = types.ComparisonSummary{
+		New:        len(comparison.New),
+		Resolved:   len(comparison.Resolved),
+		Persisting: len(comparison.Persisting),
+	}
+
+	return comparison
+}
+
+// collectFindings는 스캔 결과의 실패 항목을 fingerprint와 함께 모읍니다
+// fingerprint가 없는 이전 결과는 여기서 계산합니다
+func collectFindings(results []*types.ScanResult) []types.Finding {
+	var findings []types.Finding
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			for _, misconfig := range result.Misconfigurations {
+				if misconfig.Status == "PASS" {
+					continue
+				}
+				if misconfig.Fingerprint == "" {
+					misconfig.Fingerprint = Fingerprint(result.Target, misconfig)
+				}
+				findings = append(findings, types.Finding{
+					Target:           result.Target,
+					Misconfiguration: misconfig,
+				})
+			}
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,50 @@
+package scanner
+
+import "terraform-scanner-service/internal/types"
+
+// FilterChangedLines는 변경된 줄 범위와 겹치는 실패 항목만 남깁니다
+// 위치 정보가 없는 항목과 PASS 항목은 그대로 두고, 요약의 실패 수를 다시 계산합니다
+// changed는 Result.Target 별 변경된 줄 범위입니다
+func FilterChangedLines(results []*types.ScanResult, changed map[string][]types.LineRange) {
+	for _, scanResult := range results {
+		for i := range scanResult.Results {
+			result := &scanResult.Results[i]
+			ranges := changed[result.Target]
+
+			var kept []types.Misconfiguration
+			for _, misconfig := range result.Misconfigurations {
+				if misconfig.Status == "PASS" || intersects(misconfig, ranges) {
+					kept = append(kept, misconfig)
+					continue
+				}
+				if result.MisconfSummary != nil {
+					result.MisconfSummary.Failures--
+				}
+			}
+			result.Misconfigurations = kept
+		}
+	}
+}
+
+// intersects는 항목의 원인 줄이 변경된 줄 범위와 겹치는지 확인합니다
+// 위치 정보가 없으면 변경 여부를 판단할 수 없으므로 겹치는 것으로 봅니다
+func intersects(misconfig types.Misconfiguration, ranges []types.LineRange) bool {
+	if misconfig.CauseMetadata == nil || misconfig.CauseMetadata.StartLine <= 0 {
+		return true
+	}
+
+	cause := types.LineRange{
+		Start: misconfig.CauseMetadata.StartLine,
+		End:   misconfig.CauseMetadata.EndLine,
+	}
+	if cause.End < cause.Start {
+		cause.End = cause.Start
+	}
+
+	for _, r := range ranges {
+		if cause.Overlaps(r) {
+			return true
+		}
+	}
+	return false
+}
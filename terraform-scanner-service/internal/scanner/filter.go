@@ -0,0 +1,143 @@
+package scanner
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// NormalizeFilter는 정책 필터를 정규화하고 검증합니다
+// 조건이 하나도 없으면 nil을 반환합니다
+func NormalizeFilter(filter *types.PolicyFilter) (*types.PolicyFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	normalized := &types.PolicyFilter{
+		Include:     splitValues(filter.Include),
+		Exclude:     splitValues(filter.Exclude),
+		MinSeverity: strings.ToUpper(strings.TrimSpace(filter.MinSeverity)),
+		Providers:   splitValues(filter.Providers),
+		Services:    splitValues(filter.Services),
+	}
+
+	scope, err := NormalizeFilter(filter.Scope)
+	if err != nil {
+		return nil, err
+	}
+	normalized.Scope = scope
+
+	if normalized.MinSeverity != "" && !types.IsValidSeverity(normalized.MinSeverity) {
+		return nil, fmt.Errorf("invalid severity: %s", filter.MinSeverity)
+	}
+
+	// glob 패턴 검증
+	for _, pattern := range append(normalized.Include, normalized.Exclude...) {
+		if _, err := path.Match(strings.ToLower(pattern), ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	if normalized.IsEmpty() {
+		return nil, nil
+	}
+
+	return normalized, nil
+}
+
+// matchFilter는 정책이 필터 조건을 만족하는지 확인합니다
+func matchFilter(filter *types.PolicyFilter, meta *types.PolicyMetadata) bool {
+	if filter == nil {
+		return true
+	}
+
+	for _, pattern := range filter.Exclude {
+		if matchPolicyPattern(pattern, meta) {
+			return false
+		}
+	}
+
+	if len(filter.Include) > 0 {
+		included := false
+		for _, pattern := range filter.Include {
+			if matchPolicyPattern(pattern, meta) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	if filter.MinSeverity != "" &&
+		types.SeverityRank(meta.Severity) < types.SeverityRank(filter.MinSeverity) {
+		return false
+	}
+
+	if len(filter.Providers) > 0 && !containsFold(filter.Providers, meta.Provider) {
+		return false
+	}
+
+	if len(filter.Services) > 0 && !containsFold(filter.Services, meta.Service) {
+		return false
+	}
+
+	return matchFilter(filter.Scope, meta)
+}
+
+// matchSeverity는 정책의 심각도를 severity로 보고 필터 조건을 만족하는지 확인합니다
+func matchSeverity(filter *types.PolicyFilter, meta *types.PolicyMetadata, severity string) bool {
+	filterMeta := *meta
+	filterMeta.Severity = severity
+	return matchFilter(filter, &filterMeta)
+}
+
+// matchPolicyPattern은 ID, AVDID, short code, 네임스페이스 중 하나가 패턴과 일치하는지 확인합니다
+// 패턴은 정확히 일치, glob (예: AVD-AWS-*), 네임스페이스 접두사 (예: builtin.aws) 를 지원합니다
+func matchPolicyPattern(pattern string, meta *types.PolicyMetadata) bool {
+	pattern = strings.ToLower(pattern)
+
+	for _, candidate := range []string{meta.ID, meta.AVDID, meta.ShortCode, meta.Namespace} {
+		if candidate == "" {
+			continue
+		}
+		candidate = strings.ToLower(candidate)
+
+		if candidate == pattern {
+			return true
+		}
+		if ok, _ := path.Match(pattern, candidate); ok {
+			return true
+		}
+	}
+
+	// 네임스페이스 접두사 일치
+	namespace := strings.ToLower(meta.Namespace)
+	return namespace != "" && strings.HasPrefix(namespace, pattern+".")
+}
+
+// splitValues는 쉼표로 구분된 값을 분리하고 공백을 제거합니다
+func splitValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// containsFold는 대소문자 구분 없이 값이 목록에 포함되는지 확인합니다
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
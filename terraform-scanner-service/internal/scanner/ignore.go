@@ -0,0 +1,72 @@
+package scanner
+
+import (
+	"path"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// applyIgnoreRules는 무시 규칙에 해당하는 항목을 제거합니다
+func applyIgnoreRules(misconfigs []types.Misconfiguration, target string, rules []types.IgnoreRule) []types.Misconfiguration {
+	if len(rules) == 0 {
+		return misconfigs
+	}
+
+	var filtered []types.Misconfiguration
+	for _, misconfig := range misconfigs {
+		ignored := false
+		for _, rule := range rules {
+			if matchIgnoreRule(rule, misconfig, target) {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			filtered = append(filtered, misconfig)
+		}
+	}
+
+	return filtered
+}
+
+// matchIgnoreRule은 항목이 무시 규칙의 모든 조건을 만족하는지 확인합니다
+func matchIgnoreRule(rule types.IgnoreRule, misconfig types.Misconfiguration, target string) bool {
+	if rule.ID == "" && rule.Resource == "" && rule.Target == "" {
+		return false
+	}
+
+	if rule.ID != "" &&
+		!matchGlob(rule.ID, misconfig.ID) && !matchGlob(rule.ID, misconfig.AVDID) {
+		return false
+	}
+
+	if rule.Resource != "" {
+		resource := ""
+		if misconfig.CauseMetadata != nil {
+			resource = misconfig.CauseMetadata.Resource
+		}
+		if !matchGlob(rule.Resource, resource) {
+			return false
+		}
+	}
+
+	if rule.Target != "" &&
+		!matchGlob(rule.Target, target) && !matchGlob(rule.Target, path.Base(target)) {
+		return false
+	}
+
+	return true
+}
+
+// matchGlob은 대소문자 구분 없이 glob 패턴 일치 여부를 확인합니다
+func matchGlob(pattern, value string) bool {
+	pattern = strings.ToLower(pattern)
+	value = strings.ToLower(value)
+
+	if pattern == value {
+		return true
+	}
+	ok, _ := path.Match(pattern, value)
+	return ok
+}
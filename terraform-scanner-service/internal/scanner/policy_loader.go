@@ -1,10 +1,16 @@
 package scanner
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/fs"
+	"log"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/open-policy-agent/opa/ast"
@@ -15,18 +21,38 @@ import (
 
 // PolicyLoader는 Rego 정책을 로드하고 컴파일합니다
 type PolicyLoader struct {
-	policyDir string
-	modules   map[string]*ast.Module
-	metadata  map[string]*types.PolicyMetadata
-	compiler  *ast.Compiler
+	policies       fs.FS // 정책 파일 시스템 (cloud 디렉토리 포함)
+	lib            fs.FS // 정책 라이브러리 파일 시스템 (nil 가능)
+	modules        map[string]*ast.Module
+	metadata       map[string]*types.PolicyMetadata
+	moduleMetadata map[string]*types.PolicyMetadata // 모듈 경로 -> 메타데이터
+	digests        map[string]string                // 모듈 경로 -> 파일 내용 해시
+	revision       string                           // 컴파일된 정책 전체의 해시
+	compiler       *ast.Compiler
 }
 
-// NewPolicyLoader는 PolicyLoader를 생성합니다
+// NewPolicyLoader는 정책 디렉토리에서 정책을 로드하는 PolicyLoader를 생성합니다
+// 정책 디렉토리와 같은 위치의 lib 디렉토리 (../lib) 가 있으면 라이브러리로 함께 로드합니다
 func NewPolicyLoader(policyDir string) (*PolicyLoader, error) {
+	var lib fs.FS
+	libDir := filepath.Join(policyDir, "../lib")
+	if info, err := os.Stat(libDir); err == nil && info.IsDir() {
+		lib = os.DirFS(libDir)
+	}
+
+	return NewPolicyLoaderFS(os.DirFS(policyDir), lib)
+}
+
+// NewPolicyLoaderFS는 파일 시스템 (embed.FS, 인메모리 등) 에서 정책을 로드하는 PolicyLoader를 생성합니다
+// policies는 cloud 디렉토리를 포함해야 하며, lib는 정책 라이브러리입니다 (nil 가능)
+func NewPolicyLoaderFS(policies, lib fs.FS) (*PolicyLoader, error) {
 	pl := &PolicyLoader{
-		policyDir: policyDir,
-		modules:   make(map[string]*ast.Module),
-		metadata:  make(map[string]*types.PolicyMetadata),
+		policies:       policies,
+		lib:            lib,
+		modules:        make(map[string]*ast.Module),
+		metadata:       make(map[string]*types.PolicyMetadata),
+		moduleMetadata: make(map[string]*types.PolicyMetadata),
+		digests:        make(map[string]string),
 	}
 
 	if err := pl.loadPolicies(); err != nil {
@@ -36,34 +62,33 @@ func NewPolicyLoader(policyDir string) (*PolicyLoader, error) {
 	if err := pl.compilePolicies(); err != nil {
 		return nil, fmt.Errorf("failed to compile policies: %w", err)
 	}
+	pl.revision = pl.computeRevision()
 
 	return pl, nil
 }
 
-// loadPolicies는 디렉토리에서 .rego 파일을 로드합니다
+// loadPolicies는 파일 시스템에서 .rego 파일을 로드합니다
 func (pl *PolicyLoader) loadPolicies() error {
 	count := 0
 
-	// 1. lib 디렉토리 로드 (라이브러리 함수들)
-	libDir := filepath.Join(pl.policyDir, "../lib")
-	if _, err := os.Stat(libDir); err == nil {
-		fmt.Println("Loading library functions from lib directory...")
-		libCount, err := pl.loadFromDirectory(libDir)
+	// 1. lib 로드 (라이브러리 함수들)
+	if pl.lib != nil {
+		log.Println("Loading library functions from lib directory...")
+		libCount, err := pl.loadFromFS(pl.lib, ".", "lib")
 		if err != nil {
-			fmt.Printf("Warning: failed to load lib: %v\n", err)
+			log.Printf("Warning: failed to load lib: %v\n", err)
 		} else {
 			count += libCount
-			fmt.Printf("Loaded %d library modules\n", libCount)
+			log.Printf("Loaded %d library modules\n", libCount)
 		}
 	}
 
 	// 2. cloud 디렉토리 로드 (Terraform 관련 정책)
-	cloudDir := filepath.Join(pl.policyDir, "cloud")
-	if _, err := os.Stat(cloudDir); os.IsNotExist(err) {
-		return fmt.Errorf("cloud policy directory not found: %s", cloudDir)
+	if _, err := fs.Stat(pl.policies, "cloud"); errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("cloud policy directory not found: %w", err)
 	}
 
-	cloudCount, err := pl.loadFromDirectory(cloudDir)
+	cloudCount, err := pl.loadFromFS(pl.policies, "cloud", "")
 	if err != nil {
 		return err
 	}
@@ -73,14 +98,15 @@ func (pl *PolicyLoader) loadPolicies() error {
 		return fmt.Errorf("no policy files found")
 	}
 
-	fmt.Printf("Loaded %d total policy modules\n", count)
+	log.Printf("Loaded %d total policy modules\n", count)
 	return nil
 }
 
-// loadFromDirectory는 디렉토리에서 .rego 파일을 로드합니다
-func (pl *PolicyLoader) loadFromDirectory(dir string) (int, error) {
+// loadFromFS는 파일 시스템의 dir 아래에서 .rego 파일을 로드합니다
+// 모듈은 prefix를 붙인 파일 시스템 내 경로로 등록합니다 (lib와 정책의 경로 구분)
+func (pl *PolicyLoader) loadFromFS(fsys fs.FS, dir, prefix string) (int, error) {
 	count := 0
-	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+	err := fs.WalkDir(fsys, dir, func(name string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -89,20 +115,25 @@ func (pl *PolicyLoader) loadFromDirectory(dir string) (int, error) {
 		if !d.IsDir() && strings.HasSuffix(d.Name(), ".rego") &&
 			!strings.HasSuffix(d.Name(), "_test.rego") {
 
-			content, err := os.ReadFile(path)
+			content, err := fs.ReadFile(fsys, name)
 			if err != nil {
-				return fmt.Errorf("failed to read %s: %w", path, err)
+				return fmt.Errorf("failed to read %s: %w", name, err)
 			}
+			modulePath := path.Join(prefix, name)
 
-			// Rego 모듈 파싱
-			module, err := ast.ParseModule(path, string(content))
+			// Rego 모듈 파싱 (METADATA 어노테이션 포함)
+			module, err := ast.ParseModuleWithOpts(modulePath, string(content), ast.ParserOptions{
+				ProcessAnnotation: true,
+			})
 			if err != nil {
 				// 파싱 에러는 경고만 하고 계속 진행
-				fmt.Printf("Warning: failed to parse %s: %v\n", path, err)
+				log.Printf("Warning: failed to parse %s: %v\n", modulePath, err)
 				return nil
 			}
 
-			pl.modules[path] = module
+			pl.modules[modulePath] = module
+			sum := sha256.Sum256(content)
+			pl.digests[modulePath] = hex.EncodeToString(sum[:])
 
 			// 메타데이터 추출
 			if meta := pl.extractMetadata(module); meta != nil {
@@ -131,7 +162,7 @@ func (pl *PolicyLoader) compilePolicies() error {
 			}
 		}
 
-		fmt.Printf("Warning: %d policies failed to compile, removing them...\n", len(failedFiles))
+		log.Printf("Warning: %d policies failed to compile, removing them...\n", len(failedFiles))
 
 		// 실패한 모듈 및 메타데이터 제거
 		for file := range failedFiles {
@@ -155,30 +186,30 @@ func (pl *PolicyLoader) compilePolicies() error {
 
 		if pl.compiler.Failed() {
 			// 여전히 실패하면 계속 반복
-			fmt.Printf("Warning: still have compilation errors, continuing cleanup...\n")
+			log.Printf("Warning: still have compilation errors, continuing cleanup...\n")
 			return pl.compilePolicies() // 재귀적으로 다시 시도
 		}
 	}
 
 	// 컴파일 성공 후 메타데이터 재추출
 	pl.metadata = make(map[string]*types.PolicyMetadata)
+	pl.moduleMetadata = make(map[string]*types.PolicyMetadata)
 	for path, module := range pl.modules {
-		_ = path // path 사용
 		if meta := pl.extractMetadata(module); meta != nil {
 			pl.metadata[meta.ID] = meta
-			fmt.Printf("  Policy: %s (%s) - %s\n", meta.ID, meta.Severity, meta.Title)
+			pl.moduleMetadata[path] = meta
+			log.Printf("  Policy: %s (%s) - %s\n", meta.ID, meta.Severity, meta.Title)
 		}
 	}
 
-	fmt.Printf("Compiled %d policy modules successfully\n", len(pl.modules))
-	fmt.Printf("Extracted metadata from %d policies\n", len(pl.metadata))
+	log.Printf("Compiled %d policy modules successfully\n", len(pl.modules))
+	log.Printf("Extracted metadata from %d policies\n", len(pl.metadata))
 
 	// 디버깅: 컴파일된 모듈 목록 출력
-	fmt.Println("\nSuccessfully compiled modules:")
+	log.Println("Successfully compiled modules:")
 	for path := range pl.modules {
-		fmt.Printf("  - %s\n", path)
+		log.Printf("  - %s\n", path)
 	}
-	fmt.Println()
 
 	return nil
 }
@@ -200,11 +231,17 @@ func (pl *PolicyLoader) extractMetadata(module *ast.Module) *types.PolicyMetadat
 		}
 
 		// title
+		if annotation.Title != "" {
+			meta.Title = annotation.Title
+		}
 		if title, ok := annotation.Custom["title"].(string); ok {
 			meta.Title = title
 		}
 
 		// description
+		if annotation.Description != "" {
+			meta.Description = annotation.Description
+		}
 		if desc, ok := annotation.Custom["description"].(string); ok {
 			meta.Description = desc
 		}
@@ -212,7 +249,17 @@ func (pl *PolicyLoader) extractMetadata(module *ast.Module) *types.PolicyMetadat
 		// id / avd_id
 		if id, ok := annotation.Custom["id"].(string); ok {
 			meta.ID = id
-			meta.AVDID = id
+			if meta.AVDID == "" {
+				meta.AVDID = id
+			}
+		}
+		if avdID, ok := annotation.Custom["avd_id"].(string); ok {
+			meta.AVDID = avdID
+		}
+
+		// short_code
+		if shortCode, ok := annotation.Custom["short_code"].(string); ok {
+			meta.ShortCode = shortCode
 		}
 
 		// severity
@@ -250,6 +297,7 @@ func (pl *PolicyLoader) extractMetadata(module *ast.Module) *types.PolicyMetadat
 
 	// 패키지 이름에서 provider/service 추출
 	if module.Package != nil {
+		meta.Namespace = strings.TrimPrefix(module.Package.Path.String(), "data.")
 		parts := strings.Split(module.Package.Path.String(), ".")
 		if len(parts) >= 3 {
 			// data.builtin.aws.s3 -> provider: aws, service: s3
@@ -267,11 +315,6 @@ func (pl *PolicyLoader) extractMetadata(module *ast.Module) *types.PolicyMetadat
 		return nil
 	}
 
-	// 기본값 설정
-	if meta.Severity == "" {
-		meta.Severity = "MEDIUM"
-	}
-
 	return meta
 }
 
@@ -285,11 +328,37 @@ func (pl *PolicyLoader) GetModules() map[string]*ast.Module {
 	return pl.modules
 }
 
+// GetModuleMetadata는 모듈 경로에 해당하는 정책 메타데이터를 반환합니다
+func (pl *PolicyLoader) GetModuleMetadata(path string) *types.PolicyMetadata {
+	return pl.moduleMetadata[path]
+}
+
 // GetMetadata는 정책 메타데이터를 반환합니다
 func (pl *PolicyLoader) GetMetadata() map[string]*types.PolicyMetadata {
 	return pl.metadata
 }
 
+// Revision은 컴파일된 정책 집합의 리비전 (모듈 경로와 내용의 해시) 을 반환합니다
+// 정책 파일이 바뀌면 리비전도 바뀌므로 스캔 결과 캐시 키에 사용합니다
+func (pl *PolicyLoader) Revision() string {
+	return pl.revision
+}
+
+// computeRevision은 컴파일된 모듈의 경로와 내용 해시로 리비전을 계산합니다
+func (pl *PolicyLoader) computeRevision() string {
+	paths := make([]string, 0, len(pl.modules))
+	for path := range pl.modules {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	for _, path := range paths {
+		fmt.Fprintf(h, "%s %s\n", pl.digests[path], path)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
 // Count는 로드된 정책 수를 반환합니다
 func (pl *PolicyLoader) Count() int {
 	return len(pl.modules)
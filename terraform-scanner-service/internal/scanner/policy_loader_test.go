@@ -0,0 +1,202 @@
+package scanner
+
+import (
+	"io/fs"
+	"sort"
+	"testing"
+	"testing/fstest"
+)
+
+// encryptionPolicy는 암호화되지 않은 S3 버킷을 보고하는 테스트 정책입니다
+const encryptionPolicy = `# METADATA
+# title: S3 bucket encryption
+# custom:
+#   id: TEST-S3-001
+#   provider: AWS
+#   service: S3
+#   severity: HIGH
+package custom.s3.encryption
+
+import rego.v1
+
+deny contains result if {
+	some name, bucket in input.resource.aws_s3_bucket
+	not bucket.server_side_encryption_configuration
+	result := {
+		"msg": sprintf("bucket %s is not encrypted", [name]),
+		"resource": sprintf("aws_s3_bucket.%s", [name]),
+		"startline": object.get(bucket, "__startline__", 0),
+		"endline": object.get(bucket, "__endline__", 0),
+	}
+}
+`
+
+// versioningPolicy는 lib 함수를 사용하는 테스트 정책입니다
+const versioningPolicy = `# METADATA
+# title: S3 bucket versioning
+# custom:
+#   id: TEST-S3-002
+#   provider: AWS
+#   service: S3
+#   severity: LOW
+package custom.s3.versioning
+
+import rego.v1
+
+import data.lib.util
+
+deny contains result if {
+	some name, bucket in input.resource.aws_s3_bucket
+	not util.versioned(bucket)
+	result := {"msg": sprintf("bucket %s has no versioning", [name])}
+}
+`
+
+// utilLib는 테스트 정책 라이브러리입니다
+const utilLib = `package lib.util
+
+import rego.v1
+
+versioned(bucket) if bucket.versioning.enabled == true
+`
+
+// testPolicies는 정책 디렉토리 (cloud) 와 라이브러리 디렉토리 fixture를 반환합니다
+func testPolicies() (fstest.MapFS, fstest.MapFS) {
+	policies := fstest.MapFS{
+		"cloud/aws/s3/encryption.rego":      {Data: []byte(encryptionPolicy)},
+		"cloud/aws/s3/versioning.rego":      {Data: []byte(versioningPolicy)},
+		"cloud/aws/s3/encryption_test.rego": {Data: []byte("package custom.s3.encryption_test\n")},
+		"cloud/aws/s3/README.md":            {Data: []byte("# S3\n")},
+	}
+	lib := fstest.MapFS{
+		"util.rego": {Data: []byte(utilLib)},
+	}
+	return policies, lib
+}
+
+func TestNewPolicyLoaderFS(t *testing.T) {
+	policies, lib := testPolicies()
+
+	tests := []struct {
+		name     string
+		policies fs.FS
+		lib      fs.FS
+		modules  []string
+		ids      []string
+		wantErr  bool
+	}{
+		{
+			name:     "policies and lib",
+			policies: policies,
+			lib:      lib,
+			modules:  []string{"cloud/aws/s3/encryption.rego", "cloud/aws/s3/versioning.rego", "lib/util.rego"},
+			ids:      []string{"TEST-S3-001", "TEST-S3-002"},
+		},
+		{
+			name: "policies without lib",
+			policies: fstest.MapFS{
+				"cloud/aws/s3/encryption.rego": {Data: []byte(encryptionPolicy)},
+			},
+			modules: []string{"cloud/aws/s3/encryption.rego"},
+			ids:     []string{"TEST-S3-001"},
+		},
+		{
+			name: "skips unparsable policy",
+			policies: fstest.MapFS{
+				"cloud/aws/s3/encryption.rego": {Data: []byte(encryptionPolicy)},
+				"cloud/aws/s3/broken.rego":     {Data: []byte("package broken\n\ndeny {")},
+			},
+			modules: []string{"cloud/aws/s3/encryption.rego"},
+			ids:     []string{"TEST-S3-001"},
+		},
+		{
+			name:     "missing cloud directory",
+			policies: fstest.MapFS{"checks/aws/encryption.rego": {Data: []byte(encryptionPolicy)}},
+			wantErr:  true,
+		},
+		{
+			name:     "no policy files",
+			policies: fstest.MapFS{"cloud/README.md": {Data: []byte("# policies\n")}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader, err := NewPolicyLoaderFS(tt.policies, tt.lib)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("NewPolicyLoaderFS() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPolicyLoaderFS() error = %v", err)
+			}
+
+			var modules []string
+			for name := range loader.GetModules() {
+				modules = append(modules, name)
+			}
+			sort.Strings(modules)
+			if !equalStrings(modules, tt.modules) {
+				t.Errorf("modules = %v, want %v", modules, tt.modules)
+			}
+
+			var ids []string
+			for id := range loader.GetMetadata() {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			if !equalStrings(ids, tt.ids) {
+				t.Errorf("policy IDs = %v, want %v", ids, tt.ids)
+			}
+
+			if len(loader.Revision()) != 12 {
+				t.Errorf("Revision() = %q, want 12 hex characters", loader.Revision())
+			}
+		})
+	}
+}
+
+func TestPolicyLoaderRevision(t *testing.T) {
+	policies, lib := testPolicies()
+
+	first, err := NewPolicyLoaderFS(policies, lib)
+	if err != nil {
+		t.Fatalf("NewPolicyLoaderFS() error = %v", err)
+	}
+	same, err := NewPolicyLoaderFS(policies, lib)
+	if err != nil {
+		t.Fatalf("NewPolicyLoaderFS() error = %v", err)
+	}
+	if first.Revision() != same.Revision() {
+		t.Errorf("Revision() changed for the same policies: %s != %s", first.Revision(), same.Revision())
+	}
+
+	changed := fstest.MapFS{}
+	for name, file := range policies {
+		changed[name] = file
+	}
+	changed["cloud/aws/s3/encryption.rego"] = &fstest.MapFile{Data: []byte(encryptionPolicy + "\n# changed\n")}
+	other, err := NewPolicyLoaderFS(changed, lib)
+	if err != nil {
+		t.Fatalf("NewPolicyLoaderFS() error = %v", err)
+	}
+	if first.Revision() == other.Revision() {
+		t.Errorf("Revision() did not change after editing a policy")
+	}
+}
+
+// equalStrings는 두 문자열 목록이 같은지 확인합니다 (nil과 빈 목록은 같음)
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -2,9 +2,12 @@ package scanner
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
 	"strings"
 
+	"github.com/open-policy-agent/opa/ast"
 	"github.com/open-policy-agent/opa/rego"
 
 	"terraform-scanner-service/internal/types"
@@ -23,8 +26,11 @@ func NewRegoEngine(policyLoader *PolicyLoader) *RegoEngine {
 }
 
 // Scan은 Terraform 데이터를 정책으로 스캔합니다
-func (re *RegoEngine) Scan(ctx context.Context, tfData map[string]interface{}, targetPath string) ([]types.Misconfiguration, error) {
+// 필터 조건에 맞지 않는 정책은 평가하지 않으며,
+// 위반 사항이 없는 정책은 PASS 상태의 항목으로 반환합니다
+func (re *RegoEngine) Scan(ctx context.Context, tfData map[string]interface{}, targetPath string, opts ScanOptions) ([]types.Misconfiguration, error) {
 	var misconfigs []types.Misconfiguration
+	severities := newSeverityResolver(opts)
 
 	// 모든 정책 모듈을 순회하며 평가
 	for modulePath, module := range re.policyLoader.GetModules() {
@@ -36,18 +42,46 @@ func (re *RegoEngine) Scan(ctx context.Context, tfData map[string]interface{}, t
 
 		namespace := strings.TrimPrefix(packagePath, "data.")
 
+		// 필터 적용 (평가 전에 제외, 조건부 재정의로 높아질 수 있는 정책은 평가)
+		meta := re.policyMetadata(modulePath, namespace)
+		if !matchSeverity(opts.Filter, meta, severities.maxSeverity(meta)) {
+			continue
+		}
+
 		// deny, violation, warn 규칙 찾기
 		results, err := re.evaluateModule(ctx, namespace, tfData)
 		if err != nil {
-			fmt.Printf("Warning: failed to evaluate %s: %v\n", modulePath, err)
+			log.Printf("Warning: failed to evaluate %s: %v\n", modulePath, err)
+			continue
+		}
+
+		// 취소된 경우 평가 결과를 신뢰할 수 없음
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// 위반 사항이 없으면 통과로 기록 (검사 규칙이 있는 모듈만)
+		if len(results) == 0 {
+			if hasCheckRules(module) {
+				misconfig := re.passedMisconfiguration(meta, namespace)
+				misconfig.Severity = severities.policySeverity(meta)
+				if matchSeverity(opts.Filter, meta, misconfig.Severity) {
+					misconfigs = append(misconfigs, *misconfig)
+				}
+			}
 			continue
 		}
 
 		// 결과를 Misconfiguration으로 변환
 		for _, result := range results {
-			misconfig := re.resultToMisconfiguration(result, namespace, targetPath)
+			misconfig := re.resultToMisconfiguration(result, meta, namespace, targetPath)
 			if misconfig != nil {
-				misconfigs = append(misconfigs, *misconfig)
+				resolveLocation(misconfig, tfData)
+				re.applySeverity(misconfig, meta, severities, tfData)
+				// 최소 심각도는 리소스별 최종 심각도 기준
+				if matchSeverity(opts.Filter, meta, misconfig.Severity) {
+					misconfigs = append(misconfigs, *misconfig)
+				}
 			}
 		}
 	}
@@ -55,6 +89,17 @@ func (re *RegoEngine) Scan(ctx context.Context, tfData map[string]interface{}, t
 	return misconfigs, nil
 }
 
+// hasCheckRules는 모듈에 deny/violation/warn 규칙이 있는지 확인합니다
+func hasCheckRules(module *ast.Module) bool {
+	for _, rule := range module.Rules {
+		switch rule.Head.Name.String() {
+		case "deny", "violation", "warn":
+			return true
+		}
+	}
+	return false
+}
+
 // evaluateModule은 모듈의 규칙을 평가합니다
 func (re *RegoEngine) evaluateModule(ctx context.Context, namespace string, input interface{}) ([]map[string]interface{}, error) {
 	var allResults []map[string]interface{}
@@ -129,27 +174,83 @@ func (re *RegoEngine) evaluateRule(ctx context.Context, namespace, ruleName stri
 	return results, nil
 }
 
-// resultToMisconfiguration은 Rego 결과를 Misconfiguration으로 변환합니다
-func (re *RegoEngine) resultToMisconfiguration(result map[string]interface{}, namespace, targetPath string) *types.Misconfiguration {
-	// 메타데이터 찾기
-	var meta *types.PolicyMetadata
-	for _, m := range re.policyLoader.GetMetadata() {
-		if strings.Contains(namespace, strings.ToLower(m.Service)) {
-			meta = m
-			break
-		}
+// policyMetadata는 모듈의 메타데이터를 반환합니다
+func (re *RegoEngine) policyMetadata(modulePath, namespace string) *types.PolicyMetadata {
+	if meta := re.policyLoader.GetModuleMetadata(modulePath); meta != nil {
+		return meta
 	}
 
-	if meta == nil {
-		// 메타데이터가 없으면 기본값 사용
-		meta = &types.PolicyMetadata{
-			ID:       namespace,
-			AVDID:    namespace,
-			Title:    "Security Check",
-			Severity: "MEDIUM",
-		}
+	// 메타데이터가 없으면 기본값 사용
+	return &types.PolicyMetadata{
+		ID:        namespace,
+		AVDID:     namespace,
+		Title:     "Security Check",
+		Namespace: namespace,
+	}
+}
+
+// applySeverity는 재정의 규칙을 적용해 최종 심각도를 결정합니다
+// 원래 심각도와 다르면 OriginalSeverity에 보존합니다
+func (re *RegoEngine) applySeverity(misconfig *types.Misconfiguration, meta *types.PolicyMetadata, severities *severityResolver, tfData map[string]interface{}) {
+	var tags map[string]string
+	if misconfig.CauseMetadata != nil {
+		tags = resourceTags(tfData, misconfig.CauseMetadata.Resource)
+	}
+
+	misconfig.Severity = severities.resourceSeverity(meta, tags)
+	if original := severities.originalSeverity(meta); original != misconfig.Severity {
+		misconfig.OriginalSeverity = original
 	}
+}
 
+// resolveLocation은 정책이 라인 정보 없이 리소스만 보고한 경우 리소스 블록의 위치를 채웁니다
+func resolveLocation(misconfig *types.Misconfiguration, tfData map[string]interface{}) {
+	cause := misconfig.CauseMetadata
+	if cause == nil || cause.StartLine > 0 {
+		return
+	}
+
+	block := resourceBlock(tfData, cause.Resource)
+	cause.StartLine = toInt(block["__startline__"])
+	cause.EndLine = toInt(block["__endline__"])
+}
+
+// toInt는 Rego 결과나 파싱 결과의 숫자 값을 int로 변환합니다
+func toInt(value interface{}) int {
+	switch v := value.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case json.Number:
+		n, _ := v.Int64()
+		return int(n)
+	}
+	return 0
+}
+
+// passedMisconfiguration은 위반 사항이 없는 정책의 PASS 항목을 생성합니다
+func (re *RegoEngine) passedMisconfiguration(meta *types.PolicyMetadata, namespace string) *types.Misconfiguration {
+	return &types.Misconfiguration{
+		Type:        "Terraform Security Check",
+		ID:          meta.ID,
+		AVDID:       meta.AVDID,
+		Title:       meta.Title,
+		Description: meta.Description,
+		Message:     "No issues found",
+		Namespace:   namespace,
+		Query:       fmt.Sprintf("data.%s.deny", namespace),
+		Resolution:  meta.Resolution,
+		Severity:    meta.Severity,
+		PrimaryURL:  fmt.Sprintf("https://avd.aquasec.com/misconfig/%s", strings.ToLower(meta.AVDID)),
+		References:  meta.References,
+		Status:      "PASS",
+		Layer:       types.Layer{},
+	}
+}
+
+// resultToMisconfiguration은 Rego 결과를 Misconfiguration으로 변환합니다
+func (re *RegoEngine) resultToMisconfiguration(result map[string]interface{}, meta *types.PolicyMetadata, namespace, targetPath string) *types.Misconfiguration {
 	// 메시지 추출
 	msg, _ := result["msg"].(string)
 	if msg == "" {
@@ -163,14 +264,8 @@ func (re *RegoEngine) resultToMisconfiguration(result map[string]interface{}, na
 	resource, _ := result["resource"].(string)
 
 	// 라인 정보 추출
-	startLine := 0
-	endLine := 0
-	if line, ok := result["startline"].(float64); ok {
-		startLine = int(line)
-	}
-	if line, ok := result["endline"].(float64); ok {
-		endLine = int(line)
-	}
+	startLine := toInt(result["startline"])
+	endLine := toInt(result["endline"])
 
 	// Status 결정
 	status := "FAIL"
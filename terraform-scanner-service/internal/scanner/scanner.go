@@ -3,8 +3,13 @@ package scanner
 import (
 	"context"
 	"fmt"
+	"io/fs"
+	"log"
 	"os"
+	"path"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"terraform-scanner-service/internal/types"
@@ -12,127 +17,370 @@ import (
 
 // TerraformScanner는 Terraform 파일을 스캔하는 메인 스캐너입니다
 type TerraformScanner struct {
-	policyLoader *PolicyLoader
-	parser       *TerraformParser
-	regoEngine   *RegoEngine
+	parser   *TerraformParser
+	policies atomic.Pointer[policySet]
+	cache    *ResultCache // nil이면 캐시 사용 안 함
+
+	// load는 정책을 (다시) 로드합니다
+	load     func() (*PolicyLoader, error)
+	reloadMu sync.Mutex
+}
+
+// policySet은 로드된 정책과 이를 평가하는 Rego 엔진입니다
+// 정책을 다시 로드하면 새 policySet으로 교체되며, 진행 중인 스캔은 시작할 때의 정책을 계속 사용합니다
+type policySet struct {
+	loader *PolicyLoader
+	engine *RegoEngine
+}
+
+// ScanOptions는 스캔 요청별 옵션입니다
+type ScanOptions struct {
+	// Filter는 평가할 정책을 선택합니다 (nil이면 전체 정책)
+	Filter *types.PolicyFilter
+
+	// Profile은 적용된 프로파일 이름입니다 (결과에 기록)
+	Profile string
+
+	// SeverityOverrides는 심각도 재정의 규칙입니다 (먼저 일치한 규칙 적용)
+	SeverityOverrides []types.SeverityOverride
+
+	// DefaultSeverity는 심각도가 없는 정책에 사용할 값입니다 (기본값: MEDIUM)
+	DefaultSeverity string
+
+	// Environment는 조건부 심각도 재정의에 사용하는 스캔 환경입니다 (예: prod)
+	Environment string
+
+	// IncludePasses는 통과한 정책도 PASS 상태로 결과에 포함할지 여부입니다
+	IncludePasses bool
+
+	// Ignore는 결과에서 제외할 항목의 규칙입니다
+	Ignore []types.IgnoreRule
+
+	// ArtifactName은 결과에 보고할 이름입니다 (비어 있으면 실제 파일/디렉토리 이름)
+	// 파일 스캔이면 파일 이름, 디렉토리 스캔이면 루트 모듈 이름으로 사용하며,
+	// 업로드 파일처럼 임시 경로를 스캔할 때 원래 이름을 보고하는 데 사용합니다
+	ArtifactName string
+
+	// SkipDirs는 디렉토리 스캔 시 건너뛸 디렉토리의 glob 패턴입니다 (DefaultSkipDirs에 추가)
+	SkipDirs []string
+
+	// SkipFiles는 디렉토리 스캔 시 건너뛸 파일의 glob 패턴입니다
+	SkipFiles []string
+
+	// Modules는 디렉토리 스캔 시 스캔할 모듈의 상대 경로입니다 (비어 있으면 모든 모듈)
+	// 지정한 모듈에 Terraform 파일이 없으면 에러 대신 빈 결과를 반환합니다
+	Modules []string
+
+	// Baseline은 기존 문제의 기준선입니다 (일치하는 항목은 Baselined로 분리)
+	Baseline *types.Baseline
+
+	// Progress는 파일 단위 진행 상황을 보고받습니다 (nil 가능)
+	Progress func(done, total int) `json:"-"`
+}
+
+// reportProgress는 진행 상황 콜백이 있으면 호출합니다
+func (opts ScanOptions) reportProgress(done, total int) {
+	if opts.Progress != nil {
+		opts.Progress(done, total)
+	}
 }
 
-// NewTerraformScanner는 TerraformScanner를 생성합니다
+// NewTerraformScanner는 정책 디렉토리의 정책으로 TerraformScanner를 생성합니다
 func NewTerraformScanner(policyDir string) (*TerraformScanner, error) {
-	// 정책 로더 초기화
-	policyLoader, err := NewPolicyLoader(policyDir)
+	return newTerraformScanner(func() (*PolicyLoader, error) {
+		return NewPolicyLoader(policyDir)
+	})
+}
+
+// NewTerraformScannerFS는 파일 시스템 (embed.FS 등) 의 정책으로 TerraformScanner를 생성합니다
+// lib는 정책 라이브러리의 파일 시스템입니다 (nil 가능)
+func NewTerraformScannerFS(policies, lib fs.FS) (*TerraformScanner, error) {
+	return newTerraformScanner(func() (*PolicyLoader, error) {
+		return NewPolicyLoaderFS(policies, lib)
+	})
+}
+
+// newTerraformScanner는 정책을 로드해 TerraformScanner를 구성합니다
+func newTerraformScanner(load func() (*PolicyLoader, error)) (*TerraformScanner, error) {
+	ts := &TerraformScanner{
+		parser: NewTerraformParser(),
+		load:   load,
+	}
+
+	policyLoader, err := load()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize policy loader: %w", err)
 	}
+	ts.setPolicies(policyLoader)
+
+	return ts, nil
+}
 
-	// 파서 초기화
-	parser := NewTerraformParser()
+// setPolicies는 로드된 정책으로 Rego 엔진을 만들어 현재 정책으로 교체합니다
+func (ts *TerraformScanner) setPolicies(policyLoader *PolicyLoader) {
+	ts.policies.Store(&policySet{
+		loader: policyLoader,
+		engine: NewRegoEngine(policyLoader),
+	})
+}
 
-	// Rego 엔진 초기화
-	regoEngine := NewRegoEngine(policyLoader)
+// ReloadPolicies는 정책을 다시 로드해 교체하고, 이전 정책으로 캐시된 결과를 무효화합니다
+// 로드에 실패하면 기존 정책을 그대로 사용합니다
+func (ts *TerraformScanner) ReloadPolicies() error {
+	ts.reloadMu.Lock()
+	defer ts.reloadMu.Unlock()
 
-	return &TerraformScanner{
-		policyLoader: policyLoader,
-		parser:       parser,
-		regoEngine:   regoEngine,
-	}, nil
+	policyLoader, err := ts.load()
+	if err != nil {
+		return fmt.Errorf("failed to reload policies: %w", err)
+	}
+	ts.setPolicies(policyLoader)
+
+	invalidated := 0
+	if ts.cache != nil {
+		invalidated = ts.cache.Purge()
+	}
+	log.Printf("Reloaded %d policies (revision %s), invalidated %d cached results\n",
+		policyLoader.Count(), policyLoader.Revision(), invalidated)
+
+	return nil
+}
+
+// EnableCache는 최대 maxEntries개의 파일 결과를 보관하는 결과 캐시를 사용합니다
+// 스캔을 시작하기 전에 호출해야 합니다
+func (ts *TerraformScanner) EnableCache(maxEntries int) {
+	ts.cache = NewResultCache(maxEntries)
+}
+
+// CacheStats는 결과 캐시의 적중/실패 수와 항목 수를 반환합니다
+func (ts *TerraformScanner) CacheStats() CacheStats {
+	if ts.cache == nil {
+		return CacheStats{}
+	}
+	return ts.cache.Stats()
 }
 
 // ScanFile은 단일 Terraform 파일을 스캔합니다
-func (ts *TerraformScanner) ScanFile(ctx context.Context, path string) (*types.ScanResult, error) {
+func (ts *TerraformScanner) ScanFile(ctx context.Context, file string, opts ScanOptions) (*types.ScanResult, error) {
+	return ts.ScanFSFile(ctx, os.DirFS(filepath.Dir(file)), filepath.Base(file), opts)
+}
+
+// ScanFSFile은 파일 시스템의 단일 Terraform 파일을 스캔합니다
+// 결과의 이름과 Target은 opts.ArtifactName 또는 파일 이름입니다
+func (ts *TerraformScanner) ScanFSFile(ctx context.Context, fsys fs.FS, file string, opts ScanOptions) (*types.ScanResult, error) {
+	name := path.Base(file)
+	if opts.ArtifactName != "" {
+		name = opts.ArtifactName
+	}
+
+	result, err := ts.scanFSFile(ctx, ts.policies.Load(), fsys, file, name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newScanResult(name, []types.Result{*result}, opts), nil
+}
+
+// scanFSFile은 파일 시스템의 파일 하나를 읽고 파싱해 target 이름의 결과를 생성합니다
+// target은 무시 규칙, 지문, 기준선 비교에 사용되는 스캔 루트 기준 경로입니다
+// 같은 내용, 정책, 옵션의 결과가 캐시에 있으면 평가 없이 캐시된 결과를 반환합니다
+func (ts *TerraformScanner) scanFSFile(ctx context.Context, policies *policySet, fsys fs.FS, file, target string, opts ScanOptions) (*types.Result, error) {
+	content, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var key string
+	if ts.cache != nil {
+		if key, err = resultCacheKey(policies.loader.Revision(), opts, target, content); err != nil {
+			return nil, err
+		}
+		if result, ok := ts.cache.Get(key); ok {
+			return result, nil
+		}
+	}
+
+	result, err := ts.scanContent(ctx, policies, content, file, target, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 취소된 스캔의 결과는 불완전할 수 있으므로 캐시하지 않음
+	if ts.cache != nil && ctx.Err() == nil {
+		ts.cache.Put(key, result)
+	}
+	return result, nil
+}
+
+// scanContent는 파일 내용을 파싱하고 정책을 평가해 target 이름의 결과를 생성합니다
+func (ts *TerraformScanner) scanContent(ctx context.Context, policies *policySet, content []byte, file, target string, opts ScanOptions) (*types.Result, error) {
 	// 파일 파싱
-	tfData, err := ts.parser.ParseFile(path)
+	tfData, err := ts.parser.ParseContent(content, file)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse file: %w", err)
 	}
 
 	// Rego 정책으로 스캔
-	misconfigs, err := ts.regoEngine.Scan(ctx, tfData, path)
+	misconfigs, err := policies.engine.Scan(ctx, tfData, file, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan: %w", err)
 	}
+	misconfigs = applyIgnoreRules(misconfigs, target, opts.Ignore)
+	setFingerprints(target, misconfigs)
+	attachCode(content, misconfigs)
+	misconfigs, baselined := applyBaseline(misconfigs, opts.Baseline)
+	summary, misconfigs := summarizeMisconfigs(misconfigs, opts.IncludePasses)
+	summary.Baselined = len(baselined)
+
+	return &types.Result{
+		Target:            target,
+		Class:             "config",
+		Type:              "terraform",
+		MisconfSummary:    summary,
+		Misconfigurations: misconfigs,
+		Baselined:         baselined,
+	}, nil
+}
 
-	// 결과 구성
-	result := &types.ScanResult{
+// newScanResult는 아티팩트 단위의 스캔 결과를 구성합니다
+func newScanResult(artifactName string, results []types.Result, opts ScanOptions) *types.ScanResult {
+	return &types.ScanResult{
 		SchemaVersion: 2,
 		CreatedAt:     time.Now(),
-		ArtifactName:  filepath.Base(path),
+		ArtifactName:  artifactName,
 		ArtifactType:  "terraform",
-		Results: []types.Result{
-			{
-				Target:            filepath.Base(path),
-				Class:             "config",
-				Type:              "terraform",
-				Misconfigurations: misconfigs,
-			},
-		},
+		Profile:       opts.Profile,
+		PolicyFilter:  opts.Filter,
+		Results:       results,
 	}
+}
 
-	return result, nil
+// summarizeMisconfigs는 통과/실패 수를 집계합니다
+// includePasses가 false면 PASS 항목은 결과에서 제거합니다
+func summarizeMisconfigs(misconfigs []types.Misconfiguration, includePasses bool) (*types.MisconfSummary, []types.Misconfiguration) {
+	summary := &types.MisconfSummary{}
+	var filtered []types.Misconfiguration
+
+	for _, misconfig := range misconfigs {
+		if misconfig.Status == "PASS" {
+			summary.Successes++
+			if !includePasses {
+				continue
+			}
+		} else {
+			summary.Failures++
+		}
+		filtered = append(filtered, misconfig)
+	}
+
+	return summary, filtered
 }
 
-// ScanDirectory는 디렉토리의 모든 .tf 파일을 스캔합니다
-func (ts *TerraformScanner) ScanDirectory(ctx context.Context, dir string) ([]*types.ScanResult, error) {
-	var results []*types.ScanResult
+// ScanDirectory는 디렉토리를 재귀적으로 탐색해 모든 .tf 파일을 스캔합니다
+// Terraform 파일이 있는 디렉토리마다 하나의 결과 (모듈) 를 반환하며,
+// 각 파일의 Target은 스캔 루트 기준 상대 경로입니다
+func (ts *TerraformScanner) ScanDirectory(ctx context.Context, dir string, opts ScanOptions) ([]*types.ScanResult, error) {
+	return ts.ScanFS(ctx, os.DirFS(dir), filepath.Base(filepath.Clean(dir)), opts)
+}
 
-	entries, err := os.ReadDir(dir)
+// ScanFS는 파일 시스템 (디렉토리, git 트리 등) 의 모든 .tf 파일을 모듈 단위로 스캔합니다
+// name은 루트 모듈의 이름입니다 (opts.ArtifactName이 있으면 그 값을 사용)
+func (ts *TerraformScanner) ScanFS(ctx context.Context, fsys fs.FS, name string, opts ScanOptions) ([]*types.ScanResult, error) {
+	modules, err := discoverModules(fsys, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read directory: %w", err)
+		return nil, err
 	}
+	policies := ts.policies.Load()
 
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
+	total := 0
+	for _, m := range modules {
+		total += len(m.Files)
+	}
+	opts.reportProgress(0, total)
 
-		name := entry.Name()
-		if filepath.Ext(name) == ".tf" || filepath.Ext(name) == ".tfvars" {
-			path := filepath.Join(dir, name)
+	var results []*types.ScanResult
+	done := 0
+
+	for _, m := range modules {
+		var fileResults []types.Result
 
-			result, err := ts.ScanFile(ctx, path)
+		for _, target := range m.Files {
+			result, err := ts.scanFSFile(ctx, policies, fsys, target, target, opts)
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
 			if err != nil {
-				fmt.Printf("Warning: failed to scan %s: %v\n", name, err)
-				continue
+				log.Printf("Warning: failed to scan %s: %v\n", target, err)
+			} else {
+				fileResults = append(fileResults, *result)
 			}
 
-			results = append(results, result)
+			done++
+			opts.reportProgress(done, total)
+		}
+
+		if len(fileResults) > 0 {
+			results = append(results, newScanResult(moduleName(name, m.Path, opts.ArtifactName), fileResults, opts))
 		}
 	}
 
 	if len(results) == 0 {
-		return nil, fmt.Errorf("no Terraform files found in %s", dir)
+		if len(opts.Modules) > 0 {
+			return []*types.ScanResult{}, nil
+		}
+		return nil, fmt.Errorf("no Terraform files found in %s", name)
 	}
 
 	return results, nil
 }
 
+// moduleName은 모듈의 아티팩트 이름입니다 (루트 모듈은 지정된 이름 또는 루트 이름, 그 외는 상대 경로)
+func moduleName(rootName, modulePath, override string) string {
+	if modulePath == "." {
+		if override != "" {
+			return override
+		}
+		return rootName
+	}
+	return modulePath
+}
+
 // ScanTarget은 파일 또는 디렉토리를 스캔합니다
-func (ts *TerraformScanner) ScanTarget(ctx context.Context, target string) ([]*types.ScanResult, error) {
+func (ts *TerraformScanner) ScanTarget(ctx context.Context, target string, opts ScanOptions) ([]*types.ScanResult, error) {
 	info, err := os.Stat(target)
 	if err != nil {
 		return nil, fmt.Errorf("target not found: %w", err)
 	}
 
 	if info.IsDir() {
-		return ts.ScanDirectory(ctx, target)
+		return ts.ScanDirectory(ctx, target, opts)
 	}
 
-	result, err := ts.ScanFile(ctx, target)
+	opts.reportProgress(0, 1)
+
+	result, err := ts.ScanFile(ctx, target, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	opts.reportProgress(1, 1)
+
 	return []*types.ScanResult{result}, nil
 }
 
 // PolicyCount는 로드된 정책 수를 반환합니다
 func (ts *TerraformScanner) PolicyCount() int {
-	return ts.policyLoader.Count()
+	return ts.policies.Load().loader.Count()
+}
+
+// PolicyRevision은 현재 정책 집합의 리비전을 반환합니다
+func (ts *TerraformScanner) PolicyRevision() string {
+	return ts.policies.Load().loader.Revision()
 }
 
 // GetPolicies는 로드된 정책의 메타데이터를 반환합니다
 func (ts *TerraformScanner) GetPolicies() []*types.PolicyMetadata {
-	metadata := ts.policyLoader.GetMetadata()
+	metadata := ts.policies.Load().loader.GetMetadata()
 	policies := make([]*types.PolicyMetadata, 0, len(metadata))
 
 	for _, meta := range metadata {
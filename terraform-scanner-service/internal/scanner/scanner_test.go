@@ -0,0 +1,268 @@
+package scanner
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"terraform-scanner-service/internal/memfs"
+	"terraform-scanner-service/internal/types"
+)
+
+// 테스트용 Terraform 파일
+const (
+	// unencryptedBucket은 암호화 정책만 실패합니다
+	unencryptedBucket = `resource "aws_s3_bucket" "logs" {
+  bucket     = "logs"
+  versioning = { enabled = true }
+}
+`
+
+	// compliantBucket은 모든 정책을 통과합니다
+	compliantBucket = `resource "aws_s3_bucket" "data" {
+  bucket                               = "data"
+  versioning                           = { enabled = true }
+  server_side_encryption_configuration = { algorithm = "aws:kms" }
+}
+`
+
+	// bareBucket은 암호화, 버전 관리 정책이 모두 실패합니다
+	bareBucket = `resource "aws_s3_bucket" "tmp" {
+  bucket = "tmp"
+}
+`
+)
+
+// newTestScanner는 테스트 정책으로 스캐너를 생성합니다
+func newTestScanner(t *testing.T) *TerraformScanner {
+	t.Helper()
+
+	policies, lib := testPolicies()
+	tfScanner, err := NewTerraformScannerFS(policies, lib)
+	if err != nil {
+		t.Fatalf("NewTerraformScannerFS() error = %v", err)
+	}
+	return tfScanner
+}
+
+// testTree는 모듈 두 개 (루트, envs/prod) 와 건너뛸 디렉토리가 있는 파일 시스템입니다
+func testTree() *memfs.FS {
+	fsys := memfs.New(time.Time{})
+	fsys.Add("main.tf", []byte(unencryptedBucket))
+	fsys.Add("outputs.tf", []byte(compliantBucket))
+	fsys.Add("envs/prod/main.tf", []byte(bareBucket))
+	fsys.Add(".terraform/modules/x/main.tf", []byte(bareBucket))
+	fsys.Add("README.md", []byte("# infra\n"))
+	return fsys
+}
+
+// scanSummary는 결과를 비교하기 쉬운 형태로 요약합니다 (아티팩트 -> 파일 -> 정책 ID:상태)
+func scanSummary(results []*types.ScanResult) map[string]map[string][]string {
+	summary := make(map[string]map[string][]string)
+	for _, result := range results {
+		files := make(map[string][]string)
+		for _, r := range result.Results {
+			ids := []string{}
+			for _, misconfig := range r.Misconfigurations {
+				ids = append(ids, misconfig.ID+":"+misconfig.Status)
+			}
+			// 정책 평가 순서는 정해져 있지 않음
+			sort.Strings(ids)
+			files[r.Target] = ids
+		}
+		summary[result.ArtifactName] = files
+	}
+	return summary
+}
+
+func TestScanFS(t *testing.T) {
+	tfScanner := newTestScanner(t)
+
+	tests := []struct {
+		name    string
+		fsys    *memfs.FS
+		opts    ScanOptions
+		want    map[string]map[string][]string
+		wantErr bool
+	}{
+		{
+			name: "modules",
+			fsys: testTree(),
+			want: map[string]map[string][]string{
+				"infra": {
+					"main.tf":    {"TEST-S3-001:FAIL"},
+					"outputs.tf": {},
+				},
+				"envs/prod": {
+					"envs/prod/main.tf": {"TEST-S3-001:FAIL", "TEST-S3-002:FAIL"},
+				},
+			},
+		},
+		{
+			name: "artifact name and filter",
+			fsys: testTree(),
+			opts: ScanOptions{
+				ArtifactName: "repo",
+				Filter:       &types.PolicyFilter{Include: []string{"TEST-S3-002"}},
+			},
+			want: map[string]map[string][]string{
+				"repo": {
+					"main.tf":    {},
+					"outputs.tf": {},
+				},
+				"envs/prod": {
+					"envs/prod/main.tf": {"TEST-S3-002:FAIL"},
+				},
+			},
+		},
+		{
+			name: "include passes",
+			fsys: testTree(),
+			opts: ScanOptions{IncludePasses: true, Modules: []string{"."}},
+			want: map[string]map[string][]string{
+				"infra": {
+					"main.tf":    {"TEST-S3-001:FAIL", "TEST-S3-002:PASS"},
+					"outputs.tf": {"TEST-S3-001:PASS", "TEST-S3-002:PASS"},
+				},
+			},
+		},
+		{
+			name: "selected module",
+			fsys: testTree(),
+			opts: ScanOptions{Modules: []string{"envs/prod"}},
+			want: map[string]map[string][]string{
+				"envs/prod": {
+					"envs/prod/main.tf": {"TEST-S3-001:FAIL", "TEST-S3-002:FAIL"},
+				},
+			},
+		},
+		{
+			name: "selected module without files",
+			fsys: testTree(),
+			opts: ScanOptions{Modules: []string{"envs/dev"}},
+			want: map[string]map[string][]string{},
+		},
+		{
+			name:    "no Terraform files",
+			fsys:    memfs.New(time.Time{}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := tfScanner.ScanFS(context.Background(), tt.fsys, "infra", tt.opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("ScanFS() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ScanFS() error = %v", err)
+			}
+			if results == nil {
+				t.Fatal("ScanFS() = nil, want non-nil results")
+			}
+
+			got := scanSummary(results)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanFS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanFSFile(t *testing.T) {
+	tfScanner := newTestScanner(t)
+
+	fsys := testTree()
+	fsys.Add("broken.tf", []byte(`resource "aws_s3_bucket" {`))
+
+	tests := []struct {
+		name     string
+		file     string
+		opts     ScanOptions
+		artifact string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "file name as target",
+			file:     "envs/prod/main.tf",
+			artifact: "main.tf",
+			want:     []string{"TEST-S3-001:FAIL", "TEST-S3-002:FAIL"},
+		},
+		{
+			name:     "artifact name as target",
+			file:     "main.tf",
+			opts:     ScanOptions{ArtifactName: "upload.tf"},
+			artifact: "upload.tf",
+			want:     []string{"TEST-S3-001:FAIL"},
+		},
+		{
+			name:     "min severity",
+			file:     "envs/prod/main.tf",
+			opts:     ScanOptions{Filter: &types.PolicyFilter{MinSeverity: types.SeverityHigh}},
+			artifact: "main.tf",
+			want:     []string{"TEST-S3-001:FAIL"},
+		},
+		{
+			name:    "missing file",
+			file:    "missing.tf",
+			wantErr: true,
+		},
+		{
+			name:    "parse error",
+			file:    "broken.tf",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tfScanner.ScanFSFile(context.Background(), fsys, tt.file, tt.opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("ScanFSFile() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ScanFSFile() error = %v", err)
+			}
+
+			want := map[string]map[string][]string{
+				tt.artifact: {tt.artifact: tt.want},
+			}
+			if got := scanSummary([]*types.ScanResult{result}); !reflect.DeepEqual(got, want) {
+				t.Errorf("ScanFSFile() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestScanFSCache(t *testing.T) {
+	tfScanner := newTestScanner(t)
+	tfScanner.EnableCache(10)
+
+	fsys := testTree()
+	first, err := tfScanner.ScanFS(context.Background(), fsys, "infra", ScanOptions{})
+	if err != nil {
+		t.Fatalf("ScanFS() error = %v", err)
+	}
+	second, err := tfScanner.ScanFS(context.Background(), fsys, "infra", ScanOptions{})
+	if err != nil {
+		t.Fatalf("ScanFS() error = %v", err)
+	}
+
+	stats := tfScanner.CacheStats()
+	if stats.Misses != 3 || stats.Hits != 3 || stats.Entries != 3 {
+		t.Errorf("CacheStats() = %+v, want 3 misses, 3 hits, 3 entries", stats)
+	}
+	if got, want := scanSummary(second), scanSummary(first); !reflect.DeepEqual(got, want) {
+		t.Errorf("cached ScanFS() = %v, want %v", got, want)
+	}
+}
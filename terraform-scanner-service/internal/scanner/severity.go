@@ -0,0 +1,170 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// defaultSeverity는 정책에 심각도가 없을 때 사용하는 기본값입니다
+const defaultSeverity = types.SeverityMedium
+
+// severityResolver는 정책의 최종 심각도를 결정합니다
+type severityResolver struct {
+	overrides       []types.SeverityOverride
+	defaultSeverity string
+	environment     string
+}
+
+// newSeverityResolver는 스캔 옵션으로 severityResolver를 생성합니다
+func newSeverityResolver(opts ScanOptions) *severityResolver {
+	resolver := &severityResolver{
+		overrides:       opts.SeverityOverrides,
+		defaultSeverity: strings.ToUpper(opts.DefaultSeverity),
+		environment:     opts.Environment,
+	}
+	if resolver.defaultSeverity == "" {
+		resolver.defaultSeverity = defaultSeverity
+	}
+	return resolver
+}
+
+// policySeverity는 조건 없는 재정의만 적용한 정책 심각도를 반환합니다
+func (sr *severityResolver) policySeverity(meta *types.PolicyMetadata) string {
+	for _, override := range sr.overrides {
+		if override.When == nil && matchOverrideID(override, meta) {
+			return strings.ToUpper(override.Severity)
+		}
+	}
+	return sr.baseSeverity(meta)
+}
+
+// maxSeverity는 조건부 재정의까지 고려해 정책의 발견 항목이 가질 수 있는 가장 높은 심각도를 반환합니다
+// 정책 필터는 평가 전에 이 값으로 적용하고, 최소 심각도는 항목별 최종 심각도로 다시 확인합니다
+func (sr *severityResolver) maxSeverity(meta *types.PolicyMetadata) string {
+	highest := ""
+	raise := func(severity string) {
+		if highest == "" || types.SeverityRank(severity) > types.SeverityRank(highest) {
+			highest = severity
+		}
+	}
+
+	for _, override := range sr.overrides {
+		if !matchOverrideID(override, meta) {
+			continue
+		}
+		// 스캔 환경은 평가 전에 알 수 있으므로 다른 환경의 규칙은 제외
+		if cond := override.When; cond != nil && len(cond.Environments) > 0 && !containsFold(cond.Environments, sr.environment) {
+			continue
+		}
+
+		raise(strings.ToUpper(override.Severity))
+		if override.When == nil {
+			// 조건 없는 규칙 뒤의 규칙은 적용되지 않음
+			return highest
+		}
+	}
+
+	raise(sr.baseSeverity(meta))
+	return highest
+}
+
+// resourceSeverity는 리소스 태그와 스캔 환경 조건까지 고려한 최종 심각도를 반환합니다
+func (sr *severityResolver) resourceSeverity(meta *types.PolicyMetadata, tags map[string]string) string {
+	for _, override := range sr.overrides {
+		if matchOverrideID(override, meta) && sr.matchCondition(override.When, tags) {
+			return strings.ToUpper(override.Severity)
+		}
+	}
+	return sr.baseSeverity(meta)
+}
+
+// originalSeverity는 정책에 정의된 원래 심각도를 반환합니다
+func (sr *severityResolver) originalSeverity(meta *types.PolicyMetadata) string {
+	if meta.Severity == "" {
+		return types.SeverityUnknown
+	}
+	return meta.Severity
+}
+
+// baseSeverity는 재정의 없이 정책 심각도를 반환합니다 (없으면 기본값)
+func (sr *severityResolver) baseSeverity(meta *types.PolicyMetadata) string {
+	if meta.Severity == "" {
+		return sr.defaultSeverity
+	}
+	return meta.Severity
+}
+
+// matchCondition은 재정의 조건이 스캔 환경과 리소스 태그에 맞는지 확인합니다
+func (sr *severityResolver) matchCondition(cond *types.SeverityCondition, tags map[string]string) bool {
+	if cond == nil {
+		return true
+	}
+
+	if len(cond.Environments) > 0 && !containsFold(cond.Environments, sr.environment) {
+		return false
+	}
+
+	for key, expected := range cond.Tags {
+		actual, ok := tags[key]
+		if !ok {
+			return false
+		}
+		if expected != "*" && !strings.EqualFold(expected, actual) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchOverrideID는 재정의 규칙의 ID 패턴이 정책과 일치하는지 확인합니다
+func matchOverrideID(override types.SeverityOverride, meta *types.PolicyMetadata) bool {
+	return matchGlob(override.ID, meta.ID) || matchGlob(override.ID, meta.AVDID)
+}
+
+// ValidateSeverityOverrides는 심각도 재정의 규칙을 검증하고 정규화합니다
+func ValidateSeverityOverrides(overrides []types.SeverityOverride) error {
+	for i := range overrides {
+		override := &overrides[i]
+		if override.ID == "" {
+			return fmt.Errorf("severity override id is required")
+		}
+		if !types.IsValidSeverity(override.Severity) {
+			return fmt.Errorf("invalid severity for %s: %s", override.ID, override.Severity)
+		}
+		override.Severity = strings.ToUpper(override.Severity)
+	}
+	return nil
+}
+
+// resourceBlock은 리소스 주소 (예: aws_s3_bucket.data) 에 해당하는 파싱된 블록을 찾습니다
+// 모듈 접두사가 있으면 마지막 두 부분만 사용합니다
+func resourceBlock(tfData map[string]interface{}, resource string) map[string]interface{} {
+	parts := strings.Split(resource, ".")
+	if len(parts) < 2 {
+		return nil
+	}
+	resourceType, resourceName := parts[len(parts)-2], parts[len(parts)-1]
+
+	resources, _ := tfData["resource"].(map[string]interface{})
+	byType, _ := resources[resourceType].(map[string]interface{})
+	block, _ := byType[resourceName].(map[string]interface{})
+	return block
+}
+
+// resourceTags는 Terraform 데이터에서 리소스의 tags 값을 찾습니다
+// resource는 "aws_s3_bucket.example" 또는 "module.x.aws_s3_bucket.example" 형식입니다
+func resourceTags(tfData map[string]interface{}, resource string) map[string]string {
+	rawTags, _ := resourceBlock(tfData, resource)["tags"].(map[string]interface{})
+	if len(rawTags) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(rawTags))
+	for key, value := range rawTags {
+		tags[key] = fmt.Sprint(value)
+	}
+	return tags
+}
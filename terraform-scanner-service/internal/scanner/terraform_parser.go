@@ -2,41 +2,48 @@ package scanner
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
+	"io/fs"
+	"log"
+	"path"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/zclconf/go-cty/cty"
 )
 
 // TerraformParser는 Terraform 파일을 파싱합니다
-type TerraformParser struct {
-	parser *hclparse.Parser
-}
+// hclparse.Parser는 파일명별로 파싱 결과를 계속 보관하고 동시 사용에 안전하지 않으므로
+// 파일마다 새 파서를 사용합니다 (업로드 경로가 요청마다 달라 캐시가 무한히 커지는 문제 방지)
+type TerraformParser struct{}
 
 // NewTerraformParser는 TerraformParser를 생성합니다
 func NewTerraformParser() *TerraformParser {
-	return &TerraformParser{
-		parser: hclparse.NewParser(),
-	}
+	return &TerraformParser{}
 }
 
-// ParseFile은 단일 Terraform 파일을 파싱합니다
-func (tp *TerraformParser) ParseFile(path string) (map[string]interface{}, error) {
-	content, err := os.ReadFile(path)
+// ParseFile은 파일 시스템 (디렉토리, 업로드, git 트리 등) 의 Terraform 파일 하나를 파싱합니다
+func (tp *TerraformParser) ParseFile(fsys fs.FS, name string) (map[string]interface{}, error) {
+	content, err := fs.ReadFile(fsys, name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
+	return tp.ParseContent(content, name)
+}
+
+// ParseContent는 메모리에 있는 Terraform 파일 내용을 파싱합니다
+// path는 파일 형식 (.json) 판단과 에러 메시지에 사용됩니다
+func (tp *TerraformParser) ParseContent(content []byte, path string) (map[string]interface{}, error) {
 	var file *hcl.File
 	var diags hcl.Diagnostics
 
+	parser := hclparse.NewParser()
 	if strings.HasSuffix(path, ".json") {
-		file, diags = tp.parser.ParseJSON(content, path)
+		file, diags = parser.ParseJSON(content, path)
 	} else {
-		file, diags = tp.parser.ParseHCL(content, path)
+		file, diags = parser.ParseHCL(content, path)
 	}
 
 	if diags.HasErrors() {
@@ -52,11 +59,11 @@ func (tp *TerraformParser) ParseFile(path string) (map[string]interface{}, error
 	return result, nil
 }
 
-// ParseDirectory는 디렉토리의 모든 .tf 파일을 파싱합니다
-func (tp *TerraformParser) ParseDirectory(dir string) (map[string]interface{}, error) {
+// ParseDirectory는 파일 시스템의 디렉토리에 있는 모든 .tf 파일을 파싱합니다
+func (tp *TerraformParser) ParseDirectory(fsys fs.FS, dir string) (map[string]interface{}, error) {
 	merged := make(map[string]interface{})
 
-	entries, err := os.ReadDir(dir)
+	entries, err := fs.ReadDir(fsys, dir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read directory: %w", err)
 	}
@@ -68,10 +75,9 @@ func (tp *TerraformParser) ParseDirectory(dir string) (map[string]interface{}, e
 
 		name := entry.Name()
 		if strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json") {
-			path := filepath.Join(dir, name)
-			result, err := tp.ParseFile(path)
+			result, err := tp.ParseFile(fsys, path.Join(dir, name))
 			if err != nil {
-				fmt.Printf("Warning: failed to parse %s: %v\n", name, err)
+				log.Printf("Warning: failed to parse %s: %v\n", name, err)
 				continue
 			}
 
@@ -115,6 +121,14 @@ func (tp *TerraformParser) decodeBody(body hcl.Body) (map[string]interface{}, er
 	for _, block := range content.Blocks {
 		blockData := tp.processBlock(block)
 
+		switch block.Type {
+		case "resource", "data", "module":
+			// 정책에서 위치를 보고할 수 있도록 블록의 라인 범위 기록 (Trivy 규칙)
+			startLine, endLine := blockRange(block)
+			blockData["__startline__"] = startLine
+			blockData["__endline__"] = endLine
+		}
+
 		switch block.Type {
 		case "resource":
 			if _, ok := result["resource"]; !ok {
@@ -179,6 +193,14 @@ func (tp *TerraformParser) decodeBody(body hcl.Body) (map[string]interface{}, er
 	return result, nil
 }
 
+// blockRange는 블록의 시작/끝 라인을 반환합니다
+func blockRange(block *hcl.Block) (int, int) {
+	if body, ok := block.Body.(*hclsyntax.Body); ok {
+		return block.DefRange.Start.Line, body.SrcRange.End.Line
+	}
+	return block.DefRange.Start.Line, block.DefRange.End.Line
+}
+
 // processBlock은 블록을 재귀적으로 처리합니다
 func (tp *TerraformParser) processBlock(block *hcl.Block) map[string]interface{} {
 	result := make(map[string]interface{})
@@ -0,0 +1,113 @@
+package scanner
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+)
+
+// DefaultSkipDirs는 항상 건너뛰는 디렉토리입니다 (모듈 캐시, VCS 메타데이터)
+var DefaultSkipDirs = []string{".terraform", ".git"}
+
+// module은 스캔 대상에서 발견된 Terraform 모듈 디렉토리입니다
+type module struct {
+	// Path는 스캔 루트 기준 상대 경로입니다 (루트는 ".")
+	Path string
+
+	// Files는 모듈에 속한 .tf, .tfvars 파일의 스캔 루트 기준 경로입니다
+	Files []string
+}
+
+// ValidateSkipPatterns는 건너뛸 경로의 glob 패턴을 검증합니다
+func ValidateSkipPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid skip pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// discoverModules는 파일 시스템을 재귀적으로 탐색해 Terraform 파일이 있는 디렉토리를 모듈로 수집합니다
+// 건너뛸 디렉토리와 파일은 상대 경로 또는 이름이 glob 패턴과 일치하는지로 판단합니다
+// opts.Modules가 지정되면 해당 모듈만 반환합니다
+func discoverModules(fsys fs.FS, opts ScanOptions) ([]module, error) {
+	modules := make(map[string]*module)
+
+	err := fs.WalkDir(fsys, ".", func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if opts.SkipsDir(p) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		// 심볼릭 링크 등은 스캔 루트 밖을 가리킬 수 있으므로 일반 파일만 스캔
+		if !entry.Type().IsRegular() || !opts.IncludesFile(p) {
+			return nil
+		}
+
+		dir := path.Dir(p)
+		if modules[dir] == nil {
+			modules[dir] = &module{Path: dir}
+		}
+		modules[dir].Files = append(modules[dir].Files, p)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+
+	selected := make(map[string]bool)
+	for _, m := range opts.Modules {
+		selected[path.Clean(m)] = true
+	}
+
+	result := make([]module, 0, len(modules))
+	for _, m := range modules {
+		if len(selected) > 0 && !selected[m.Path] {
+			continue
+		}
+		result = append(result, *m)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result, nil
+}
+
+// SkipsDir는 스캔 루트 기준 상대 경로의 디렉토리를 건너뛰는지 확인합니다 (루트는 건너뛰지 않음)
+func (opts ScanOptions) SkipsDir(rel string) bool {
+	if rel == "." {
+		return false
+	}
+	return matchSkip(DefaultSkipDirs, rel) || matchSkip(opts.SkipDirs, rel)
+}
+
+// IncludesFile은 스캔 루트 기준 상대 경로의 파일이 스캔 대상 Terraform 파일인지 확인합니다
+// 상위 디렉토리의 건너뛰기 여부는 확인하지 않습니다
+func (opts ScanOptions) IncludesFile(rel string) bool {
+	return isTerraformFile(rel) && !matchSkip(opts.SkipFiles, rel)
+}
+
+// matchSkip은 상대 경로 또는 마지막 이름이 패턴 중 하나와 일치하는지 확인합니다
+func matchSkip(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, rel) || matchGlob(pattern, path.Base(rel)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTerraformFile은 스캔 대상 확장자인지 확인합니다
+func isTerraformFile(name string) bool {
+	ext := path.Ext(name)
+	return ext == ".tf" || ext == ".tfvars"
+}
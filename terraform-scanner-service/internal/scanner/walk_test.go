@@ -0,0 +1,87 @@
+package scanner
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"terraform-scanner-service/internal/memfs"
+)
+
+func TestDiscoverModules(t *testing.T) {
+	fsys := memfs.New(time.Time{})
+	for _, name := range []string{
+		"main.tf",
+		"variables.tf",
+		"prod.tfvars",
+		"README.md",
+		"envs/prod/main.tf",
+		"envs/prod/generated.tf",
+		"envs/dev/main.tf",
+		"modules/vpc/main.tf",
+		"modules/vpc/.terraform/modules/x/main.tf",
+		".git/hooks/main.tf",
+		"docs/notes.txt",
+	} {
+		fsys.Add(name, []byte("# "+name))
+	}
+
+	tests := []struct {
+		name string
+		opts ScanOptions
+		want []module
+	}{
+		{
+			name: "all modules",
+			want: []module{
+				{Path: ".", Files: []string{"main.tf", "prod.tfvars", "variables.tf"}},
+				{Path: "envs/dev", Files: []string{"envs/dev/main.tf"}},
+				{Path: "envs/prod", Files: []string{"envs/prod/generated.tf", "envs/prod/main.tf"}},
+				{Path: "modules/vpc", Files: []string{"modules/vpc/main.tf"}},
+			},
+		},
+		{
+			name: "skip dirs by name and path",
+			opts: ScanOptions{SkipDirs: []string{"dev", "modules/*"}},
+			want: []module{
+				{Path: ".", Files: []string{"main.tf", "prod.tfvars", "variables.tf"}},
+				{Path: "envs/prod", Files: []string{"envs/prod/generated.tf", "envs/prod/main.tf"}},
+			},
+		},
+		{
+			name: "skip files",
+			opts: ScanOptions{SkipFiles: []string{"*.tfvars", "envs/prod/generated.tf"}},
+			want: []module{
+				{Path: ".", Files: []string{"main.tf", "variables.tf"}},
+				{Path: "envs/dev", Files: []string{"envs/dev/main.tf"}},
+				{Path: "envs/prod", Files: []string{"envs/prod/main.tf"}},
+				{Path: "modules/vpc", Files: []string{"modules/vpc/main.tf"}},
+			},
+		},
+		{
+			name: "selected modules",
+			opts: ScanOptions{Modules: []string{"envs/prod/", "modules/vpc"}},
+			want: []module{
+				{Path: "envs/prod", Files: []string{"envs/prod/generated.tf", "envs/prod/main.tf"}},
+				{Path: "modules/vpc", Files: []string{"modules/vpc/main.tf"}},
+			},
+		},
+		{
+			name: "selected module without files",
+			opts: ScanOptions{Modules: []string{"docs"}},
+			want: []module{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := discoverModules(fsys, tt.opts)
+			if err != nil {
+				t.Fatalf("discoverModules() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("discoverModules() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
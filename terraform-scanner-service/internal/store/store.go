@@ -0,0 +1,259 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	bolt "go.etcd.io/bbolt"
+
+	"terraform-scanner-service/internal/types"
+)
+
+// 버킷 이름
+var (
+	scansBucket = []byte("scans") // ID -> Record
+	indexBucket = []byte("index") // 생성 시각 + ID -> Meta (최신순 조회용)
+)
+
+// ErrNotFound는 스캔 기록이 없을 때 반환됩니다
+var ErrNotFound = errors.New("scan not found")
+
+// Meta는 스캔 기록의 메타데이터입니다
+type Meta struct {
+	ID        string          `json:"id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Target    string          `json:"target"`
+	Artifacts []string        `json:"artifacts"`
+	Profile   string          `json:"profile,omitempty"`
+	Options   json.RawMessage `json:"options,omitempty"`
+	Summary   Summary         `json:"summary"`
+}
+
+// Summary는 스캔 결과 요약입니다
+type Summary struct {
+	Successes  int            `json:"successes"`
+	Failures   int            `json:"failures"`
+	Severities map[string]int `json:"severities"`
+}
+
+// Record는 저장된 스캔 기록 전체입니다
+type Record struct {
+	Meta
+	Results []*types.ScanResult `json:"results"`
+}
+
+// NewRecord는 스캔 결과로 새 기록을 생성합니다 (고유 ID 발급)
+func NewRecord(target string, options json.RawMessage, results []*types.ScanResult) *Record {
+	record := &Record{
+		Meta: Meta{
+			ID:        uuid.NewString(),
+			CreatedAt: time.Now(),
+			Target:    target,
+			Options:   options,
+			Summary:   Summarize(results),
+		},
+		Results: results,
+	}
+
+	for _, result := range results {
+		record.Artifacts = append(record.Artifacts, result.ArtifactName)
+		if record.Profile == "" {
+			record.Profile = result.Profile
+		}
+	}
+
+	return record
+}
+
+// Summarize는 스캔 결과의 통과/실패 수와 심각도별 실패 수를 집계합니다
+func Summarize(results []*types.ScanResult) Summary {
+	summary := Summary{Severities: make(map[string]int)}
+
+	for _, scanResult := range results {
+		for _, result := range scanResult.Results {
+			for _, misconfig := range result.Misconfigurations {
+				if misconfig.Status == "PASS" {
+					continue
+				}
+				summary.Severities[misconfig.Severity]++
+			}
+			if result.MisconfSummary != nil {
+				summary.Successes += result.MisconfSummary.Successes
+				summary.Failures += result.MisconfSummary.Failures
+			}
+		}
+	}
+
+	return summary
+}
+
+// Query는 스캔 기록 조회 조건입니다
+type Query struct {
+	Artifact string    // 아티팩트 이름 (부분 일치)
+	Since    time.Time // 이 시각 이후
+	Until    time.Time // 이 시각 이전
+	Severity string    // 이 심각도 이상의 실패 항목이 있는 스캔
+	Limit    int
+	Offset   int
+}
+
+// Store는 bbolt 기반 스캔 기록 저장소입니다
+type Store struct {
+	db *bolt.DB
+}
+
+// Open은 저장소 파일을 열거나 생성합니다
+func Open(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0644, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{scansBucket, indexBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close는 저장소를 닫습니다
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Save는 스캔 기록을 저장합니다
+func (s *Store) Save(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal record: %w", err)
+	}
+
+	meta, err := json.Marshal(record.Meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(scansBucket).Put([]byte(record.ID), data); err != nil {
+			return err
+		}
+		return tx.Bucket(indexBucket).Put(indexKey(record.CreatedAt, record.ID), meta)
+	})
+}
+
+// Get은 ID로 스캔 기록을 조회합니다
+func (s *Store) Get(id string) (*Record, error) {
+	var record *Record
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(scansBucket).Get([]byte(id))
+		if data == nil {
+			return ErrNotFound
+		}
+
+		record = &Record{}
+		return json.Unmarshal(data, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// List는 조건에 맞는 스캔 기록을 최신순으로 조회합니다
+// 페이지 적용 전 전체 일치 개수를 함께 반환합니다
+func (s *Store) List(query Query) ([]Meta, int, error) {
+	var metas []Meta
+	total := 0
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(indexBucket).Cursor()
+
+		for key, value := cursor.Last(); key != nil; key, value = cursor.Prev() {
+			var meta Meta
+			if err := json.Unmarshal(value, &meta); err != nil {
+				return fmt.Errorf("failed to decode metadata: %w", err)
+			}
+
+			if !query.match(meta) {
+				continue
+			}
+
+			if total >= query.Offset && (query.Limit <= 0 || len(metas) < query.Limit) {
+				metas = append(metas, meta)
+			}
+			total++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return metas, total, nil
+}
+
+// match는 메타데이터가 조회 조건을 만족하는지 확인합니다
+func (q Query) match(meta Meta) bool {
+	if !q.Since.IsZero() && meta.CreatedAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && meta.CreatedAt.After(q.Until) {
+		return false
+	}
+
+	if q.Artifact != "" {
+		matched := strings.Contains(strings.ToLower(meta.Target), strings.ToLower(q.Artifact))
+		for _, artifact := range meta.Artifacts {
+			if strings.Contains(strings.ToLower(artifact), strings.ToLower(q.Artifact)) {
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if q.Severity != "" {
+		minRank := types.SeverityRank(q.Severity)
+		found := false
+		for severity, count := range meta.Summary.Severities {
+			if count > 0 && types.SeverityRank(severity) >= minRank {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// indexKey는 시간순 정렬 가능한 인덱스 키를 생성합니다
+func indexKey(createdAt time.Time, id string) []byte {
+	key := make([]byte, 8, 8+len(id))
+	binary.BigEndian.PutUint64(key, uint64(createdAt.UnixNano()))
+	return append(key, id...)
+}
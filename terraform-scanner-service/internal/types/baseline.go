@@ -0,0 +1,22 @@
+package types
+
+import "time"
+
+// Baseline은 기존 문제를 결과에서 분리하기 위한 기준선입니다
+// 스캔 결과에서 생성하며, 이후 스캔에 전달하면 기준선에 있는 문제는 Baselined로 분류됩니다
+type Baseline struct {
+	Version   int             `json:"Version"`
+	CreatedAt time.Time       `json:"CreatedAt"`
+	ScanID    string          `json:"ScanID,omitempty"`
+	Findings  []BaselineEntry `json:"Findings"`
+}
+
+// BaselineEntry는 기준선에 포함된 개별 문제입니다
+// 비교에는 Fingerprint만 사용하며, 나머지 필드는 사람이 읽기 위한 정보입니다
+type BaselineEntry struct {
+	Fingerprint string `json:"Fingerprint"`
+	ID          string `json:"ID"`
+	Target      string `json:"Target"`
+	Resource    string `json:"Resource,omitempty"`
+	Severity    string `json:"Severity,omitempty"`
+}
@@ -0,0 +1,22 @@
+package types
+
+// Comparison은 두 스캔 결과의 비교 결과입니다
+type Comparison struct {
+	Summary    ComparisonSummary `json:"Summary"`
+	New        []Finding         `json:"New"`
+	Resolved   []Finding         `json:"Resolved"`
+	Persisting []Finding         `json:"Persisting"`
+}
+
+// ComparisonSummary는 비교 결과의 분류별 개수입니다
+type ComparisonSummary struct {
+	New        int `json:"New"`
+	Resolved   int `json:"Resolved"`
+	Persisting int `json:"Persisting"`
+}
+
+// Finding은 대상 파일 정보가 포함된 misconfiguration입니다
+type Finding struct {
+	Target string `json:"Target"`
+	Misconfiguration
+}
@@ -0,0 +1,67 @@
+package types
+
+// 스캔 요청 옵션 구조
+
+// PolicyFilter는 스캔에 적용할 정책 선택 조건입니다
+type PolicyFilter struct {
+	Include     []string `json:"Include,omitempty" yaml:"include,omitempty"`
+	Exclude     []string `json:"Exclude,omitempty" yaml:"exclude,omitempty"`
+	MinSeverity string   `json:"MinSeverity,omitempty" yaml:"min_severity,omitempty"`
+	Providers   []string `json:"Providers,omitempty" yaml:"providers,omitempty"`
+	Services    []string `json:"Services,omitempty" yaml:"services,omitempty"`
+
+	// Scope는 정책이 함께 만족해야 하는 상위 필터입니다 (프로파일 등, 요청 필터로 넓힐 수 없음)
+	Scope *PolicyFilter `json:"Scope,omitempty" yaml:"-"`
+}
+
+// IsEmpty는 필터 조건이 하나도 없는지 확인합니다
+func (f *PolicyFilter) IsEmpty() bool {
+	return f == nil ||
+		len(f.Include) == 0 &&
+			len(f.Exclude) == 0 &&
+			f.MinSeverity == "" &&
+			len(f.Providers) == 0 &&
+			len(f.Services) == 0 &&
+			f.Scope.IsEmpty()
+}
+
+// Narrow는 필터와 scope를 모두 만족해야 하는 필터를 반환합니다 (둘 다 비어 있으면 nil)
+// 원래 필터는 수정하지 않습니다
+func (f *PolicyFilter) Narrow(scope *PolicyFilter) *PolicyFilter {
+	if scope.IsEmpty() {
+		if f.IsEmpty() {
+			return nil
+		}
+		return f
+	}
+	if f.IsEmpty() {
+		return scope
+	}
+
+	narrowed := *f
+	narrowed.Scope = f.Scope.Narrow(scope)
+	return &narrowed
+}
+
+// IgnoreRule은 결과에서 제외할 발견 항목의 조건입니다
+// 지정된 조건을 모두 만족하는 항목이 제외되며, 각 값은 glob 패턴을 지원합니다
+type IgnoreRule struct {
+	ID       string `json:"ID,omitempty" yaml:"id,omitempty"`
+	Resource string `json:"Resource,omitempty" yaml:"resource,omitempty"`
+	Target   string `json:"Target,omitempty" yaml:"target,omitempty"`
+	Reason   string `json:"Reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// SeverityOverride는 정책의 심각도를 재정의하는 규칙입니다
+// When 조건이 있으면 스캔 환경 또는 리소스 태그가 일치할 때만 적용됩니다
+type SeverityOverride struct {
+	ID       string             `json:"ID" yaml:"id"`
+	Severity string             `json:"Severity" yaml:"severity"`
+	When     *SeverityCondition `json:"When,omitempty" yaml:"when,omitempty"`
+}
+
+// SeverityCondition은 심각도 재정의의 적용 조건입니다
+type SeverityCondition struct {
+	Environments []string          `json:"Environments,omitempty" yaml:"environments,omitempty"`
+	Tags         map[string]string `json:"Tags,omitempty" yaml:"tags,omitempty"`
+}
@@ -6,11 +6,38 @@ import "time"
 
 // ScanResult는 Trivy의 최상위 결과 구조입니다
 type ScanResult struct {
-	SchemaVersion int       `json:"SchemaVersion"`
-	CreatedAt     time.Time `json:"CreatedAt"`
-	ArtifactName  string    `json:"ArtifactName"`
-	ArtifactType  string    `json:"ArtifactType"`
-	Results       []Result  `json:"Results"`
+	SchemaVersion int           `json:"SchemaVersion"`
+	CreatedAt     time.Time     `json:"CreatedAt"`
+	ArtifactName  string        `json:"ArtifactName"`
+	ArtifactType  string        `json:"ArtifactType"`
+	Profile       string        `json:"Profile,omitempty"`
+	PolicyFilter  *PolicyFilter `json:"PolicyFilter,omitempty"`
+	Metadata      *Metadata     `json:"Metadata,omitempty"`
+	Results       []Result      `json:"Results"`
+}
+
+// Metadata는 스캔 대상의 출처 정보입니다 (git 저장소 스캔 시 커밋 정보)
+type Metadata struct {
+	Ref       string `json:"Ref,omitempty"`
+	Commit    string `json:"Commit,omitempty"`
+	CommitMsg string `json:"CommitMsg,omitempty"`
+	Author    string `json:"Author,omitempty"`
+	Committer string `json:"Committer,omitempty"`
+
+	// 변경분 스캔 (base..ref) 시 비교 기준 커밋
+	BaseRef    string `json:"BaseRef,omitempty"`
+	BaseCommit string `json:"BaseCommit,omitempty"`
+}
+
+// LineRange는 파일의 줄 범위입니다 (1부터 시작, 양 끝 포함)
+type LineRange struct {
+	Start int `json:"Start"`
+	End   int `json:"End"`
+}
+
+// Overlaps는 두 줄 범위가 겹치는지 확인합니다
+func (r LineRange) Overlaps(other LineRange) bool {
+	return r.Start <= other.End && other.Start <= r.End
 }
 
 // Result는 스캔 대상별 결과입니다
@@ -18,26 +45,37 @@ type Result struct {
 	Target            string             `json:"Target"`
 	Class             string             `json:"Class"`
 	Type              string             `json:"Type"`
+	MisconfSummary    *MisconfSummary    `json:"MisconfSummary,omitempty"`
 	Misconfigurations []Misconfiguration `json:"Misconfigurations,omitempty"`
+	Baselined         []Misconfiguration `json:"Baselined,omitempty"`
+}
+
+// MisconfSummary는 대상별 통과/실패 정책 수입니다
+type MisconfSummary struct {
+	Successes int `json:"Successes"`
+	Failures  int `json:"Failures"`
+	Baselined int `json:"Baselined,omitempty"`
 }
 
 // Misconfiguration은 발견된 보안 문제입니다
 type Misconfiguration struct {
-	Type          string         `json:"Type"`
-	ID            string         `json:"ID"`
-	AVDID         string         `json:"AVDID"`
-	Title         string         `json:"Title"`
-	Description   string         `json:"Description"`
-	Message       string         `json:"Message"`
-	Namespace     string         `json:"Namespace"`
-	Query         string         `json:"Query"`
-	Resolution    string         `json:"Resolution"`
-	Severity      string         `json:"Severity"`
-	PrimaryURL    string         `json:"PrimaryURL"`
-	References    []string       `json:"References"`
-	Status        string         `json:"Status"`
-	Layer         Layer          `json:"Layer"`
-	CauseMetadata *CauseMetadata `json:"CauseMetadata,omitempty"`
+	Type             string         `json:"Type"`
+	ID               string         `json:"ID"`
+	AVDID            string         `json:"AVDID"`
+	Title            string         `json:"Title"`
+	Description      string         `json:"Description"`
+	Message          string         `json:"Message"`
+	Namespace        string         `json:"Namespace"`
+	Query            string         `json:"Query"`
+	Resolution       string         `json:"Resolution"`
+	Severity         string         `json:"Severity"`
+	OriginalSeverity string         `json:"OriginalSeverity,omitempty"`
+	PrimaryURL       string         `json:"PrimaryURL"`
+	References       []string       `json:"References"`
+	Status           string         `json:"Status"`
+	Layer            Layer          `json:"Layer"`
+	CauseMetadata    *CauseMetadata `json:"CauseMetadata,omitempty"`
+	Fingerprint      string         `json:"Fingerprint,omitempty"`
 }
 
 // Layer는 레이어 정보입니다 (컨테이너용, 파일시스템에선 빈 객체)
@@ -76,6 +114,7 @@ type PolicyMetadata struct {
 	AVDID       string   `json:"avd_id"`
 	Title       string   `json:"title"`
 	ShortCode   string   `json:"short_code"`
+	Namespace   string   `json:"namespace"`
 	Description string   `json:"description"`
 	Service     string   `json:"service"`
 	Provider    string   `json:"provider"`
@@ -0,0 +1,37 @@
+package types
+
+import "strings"
+
+// 심각도 등급 (Trivy 기준)
+const (
+	SeverityUnknown  = "UNKNOWN"
+	SeverityLow      = "LOW"
+	SeverityMedium   = "MEDIUM"
+	SeverityHigh     = "HIGH"
+	SeverityCritical = "CRITICAL"
+)
+
+// Severities는 낮은 순서부터 정렬된 심각도 목록입니다
+var Severities = []string{
+	SeverityUnknown,
+	SeverityLow,
+	SeverityMedium,
+	SeverityHigh,
+	SeverityCritical,
+}
+
+// SeverityRank는 심각도의 순위를 반환합니다 (알 수 없는 값은 -1)
+func SeverityRank(severity string) int {
+	severity = strings.ToUpper(severity)
+	for i, s := range Severities {
+		if s == severity {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsValidSeverity는 지원하는 심각도인지 확인합니다
+func IsValidSeverity(severity string) bool {
+	return SeverityRank(severity) >= 0
+}
@@ -0,0 +1,326 @@
+package upload
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrLimitExceeded는 업로드가 크기 또는 항목 수 제한을 넘었을 때 반환됩니다
+var ErrLimitExceeded = errors.New("upload limit exceeded")
+
+// ErrUnsafePath는 압축 파일 항목이 작업 디렉토리 밖을 가리킬 때 반환됩니다 (zip-slip)
+var ErrUnsafePath = errors.New("unsafe path in archive")
+
+// ErrDuplicatePath는 여러 업로드 파일 (또는 압축 파일 항목) 이 같은 경로에 저장될 때 반환됩니다
+var ErrDuplicatePath = errors.New("duplicate file path in upload")
+
+// Limits는 요청당 업로드 제한입니다
+type Limits struct {
+	// MaxBytes는 압축 해제 후 전체 파일 크기의 최대값입니다
+	MaxBytes int64
+
+	// MaxEntries는 최대 항목 수입니다 (업로드 파일과, 디렉토리를 포함한 압축 파일 항목)
+	MaxEntries int
+}
+
+// maxNameLength는 저장 파일명의 최대 길이입니다 (대부분 파일 시스템의 한계)
+const maxNameLength = 255
+
+// File은 업로드된 파일 내용입니다 (zip은 임의 위치 읽기가 필요)
+type File interface {
+	io.Reader
+	io.ReaderAt
+}
+
+// Sandbox는 요청별 업로드 파일을 풀어 두는 임시 디렉토리입니다
+// 여러 파일을 추가해도 제한은 요청 전체에 대해 적용됩니다
+type Sandbox struct {
+	Dir string
+
+	limits  Limits
+	entries int
+	bytes   int64
+}
+
+// NewSandbox는 root 아래에 요청별 고유 작업 디렉토리를 생성합니다
+// 같은 이름의 파일이 동시에 업로드되어도 서로 덮어쓰지 않습니다
+func NewSandbox(root string, limits Limits) (*Sandbox, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	dir, err := os.MkdirTemp(root, "req-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	// 심볼릭 링크된 임시 디렉토리 (예: macOS /tmp) 에서도 경로 비교가 맞도록 실제 경로 사용
+	if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = resolved
+	}
+	return &Sandbox{Dir: dir, limits: limits}, nil
+}
+
+// DisplayName은 업로드 파일명에서 보고용 이름 (경로를 제외한 마지막 이름) 을 반환합니다
+func DisplayName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == ".." || name == "/" {
+		return "upload"
+	}
+	return name
+}
+
+// SanitizeName은 업로드 파일명을 작업 디렉토리에 저장할 안전한 이름으로 변환합니다
+// 경로 구분자와 제어 문자를 제거하고, 숨김 파일이나 빈 이름이 되지 않도록 합니다
+func SanitizeName(name string) string {
+	name = DisplayName(name)
+
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r < 0x20 || r == 0x7f:
+			// 제어 문자 제거
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			b.WriteRune('_')
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	sanitized := strings.TrimLeft(strings.TrimSpace(b.String()), ".")
+	if len(sanitized) > maxNameLength {
+		ext := filepath.Ext(sanitized)
+		if len(ext) > 16 {
+			ext = ""
+		}
+		sanitized = strings.ToValidUTF8(sanitized[:maxNameLength-len(ext)], "") + ext
+	}
+	if sanitized == "" {
+		return "upload"
+	}
+	return sanitized
+}
+
+// Remove는 임시 디렉토리를 삭제합니다
+func (s *Sandbox) Remove() {
+	os.RemoveAll(s.Dir)
+}
+
+// IsArchive는 파일 이름이 지원하는 압축 형식인지 확인합니다
+func IsArchive(name string) bool {
+	return archiveExt(name) != ""
+}
+
+// TrimArchiveExt는 파일 이름에서 압축 형식 확장자를 제거합니다
+func TrimArchiveExt(name string) string {
+	return strings.TrimSuffix(name, archiveExt(name))
+}
+
+// archiveExt는 지원하는 압축 형식 확장자를 반환합니다 (아니면 빈 문자열)
+func archiveExt(name string) string {
+	lower := strings.ToLower(name)
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar", ".zip"} {
+		if strings.HasSuffix(lower, ext) {
+			return name[len(name)-len(ext):]
+		}
+	}
+	return ""
+}
+
+// Add는 업로드 파일을 작업 디렉토리에 추가합니다
+// 압축 파일은 작업 디렉토리 루트에 풀고, 그 외 파일은 이름 그대로 저장한 뒤 경로를 반환합니다
+func (s *Sandbox) Add(name string, file File, size int64) (string, error) {
+	lower := strings.ToLower(name)
+
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return s.Dir, s.extractZip(file, size)
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		defer gz.Close()
+		return s.Dir, s.extractTar(gz)
+	case strings.HasSuffix(lower, ".tar"):
+		return s.Dir, s.extractTar(file)
+	default:
+		if err := s.countEntry(); err != nil {
+			return "", err
+		}
+		return s.writeFile(SanitizeName(name), file)
+	}
+}
+
+// extractZip은 zip 파일을 작업 디렉토리에 풉니다
+func (s *Sandbox) extractZip(file File, size int64) error {
+	reader, err := zip.NewReader(file, size)
+	if err != nil {
+		return fmt.Errorf("failed to read zip archive: %w", err)
+	}
+
+	for _, entry := range reader.File {
+		if err := s.countEntry(); err != nil {
+			return err
+		}
+
+		mode := entry.Mode()
+		if mode.IsDir() {
+			if _, err := s.mkdir(entry.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		if !mode.IsRegular() {
+			// 심볼릭 링크 등은 작업 디렉토리 밖을 가리킬 수 있으므로 무시
+			continue
+		}
+
+		// 선언된 크기로 먼저 확인 (실제 복사량도 writeFile에서 다시 제한)
+		if s.limits.MaxBytes > 0 && s.bytes+int64(entry.UncompressedSize64) > s.limits.MaxBytes {
+			return fmt.Errorf("%w: total size exceeds %d bytes", ErrLimitExceeded, s.limits.MaxBytes)
+		}
+
+		rc, err := entry.Open()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", entry.Name, err)
+		}
+		_, err = s.writeFile(entry.Name, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractTar는 tar 스트림을 작업 디렉토리에 풉니다
+func (s *Sandbox) extractTar(r io.Reader) error {
+	reader := tar.NewReader(r)
+
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read tar archive: %w", err)
+		}
+		if err := s.countEntry(); err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if _, err := s.mkdir(header.Name); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if _, err := s.writeFile(header.Name, reader); err != nil {
+				return err
+			}
+		default:
+			// 링크, 장치 파일 등은 무시
+		}
+	}
+}
+
+// countEntry는 항목 하나를 항목 수 제한에 집계합니다
+// 디렉토리와 무시하는 항목도 처리 비용이 있으므로 압축 파일의 모든 항목을 집계합니다
+func (s *Sandbox) countEntry() error {
+	if s.limits.MaxEntries > 0 && s.entries >= s.limits.MaxEntries {
+		return fmt.Errorf("%w: more than %d entries", ErrLimitExceeded, s.limits.MaxEntries)
+	}
+	s.entries++
+	return nil
+}
+
+// writeFile은 전체 크기 제한 안에서 파일을 기록합니다
+func (s *Sandbox) writeFile(name string, r io.Reader) (string, error) {
+	dest, err := s.resolve(name)
+	if err != nil {
+		return "", err
+	}
+	if dest == s.Dir {
+		return "", fmt.Errorf("%w: %s", ErrUnsafePath, name)
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// 먼저 저장된 파일을 덮어써 스캔에서 조용히 빠지지 않도록 같은 경로는 거부
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("%w: %s", ErrDuplicatePath, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	defer out.Close()
+
+	// 남은 허용량보다 1바이트 더 읽어 초과 여부를 판단
+	var src io.Reader = r
+	if s.limits.MaxBytes > 0 {
+		src = io.LimitReader(r, s.limits.MaxBytes-s.bytes+1)
+	}
+
+	written, err := io.Copy(out, src)
+	if err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	s.bytes += written
+	if s.limits.MaxBytes > 0 && s.bytes > s.limits.MaxBytes {
+		return "", fmt.Errorf("%w: total size exceeds %d bytes", ErrLimitExceeded, s.limits.MaxBytes)
+	}
+
+	return dest, out.Close()
+}
+
+// mkdir은 작업 디렉토리 안에 디렉토리를 생성합니다
+func (s *Sandbox) mkdir(name string) (string, error) {
+	dest, err := s.resolve(name)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+	return dest, nil
+}
+
+// resolve는 항목 이름을 작업 디렉토리 안의 경로로 변환합니다
+// 절대 경로나 ".."로 작업 디렉토리를 벗어나는 이름은 거부합니다
+func (s *Sandbox) resolve(name string) (string, error) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if name == "" || path.IsAbs(name) || filepath.IsAbs(name) {
+		return "", fmt.Errorf("%w: %s", ErrUnsafePath, name)
+	}
+	for _, part := range strings.Split(name, "/") {
+		if part == ".." {
+			return "", fmt.Errorf("%w: %s", ErrUnsafePath, name)
+		}
+	}
+
+	// "./" 같은 루트 항목은 작업 디렉토리 자체
+	cleaned := path.Clean(name)
+	if cleaned == "." {
+		return s.Dir, nil
+	}
+
+	dest := filepath.Join(s.Dir, filepath.FromSlash(cleaned))
+	if !strings.HasPrefix(dest, s.Dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %s", ErrUnsafePath, name)
+	}
+	return dest, nil
+}
@@ -0,0 +1,157 @@
+package upload
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSandboxResolve(t *testing.T) {
+	sandbox := &Sandbox{Dir: filepath.Join(t.TempDir(), "req-1")}
+
+	tests := []struct {
+		name    string
+		entry   string
+		want    string
+		wantErr bool
+	}{
+		{name: "file", entry: "main.tf", want: "main.tf"},
+		{name: "nested file", entry: "envs/prod/main.tf", want: "envs/prod/main.tf"},
+		{name: "windows separators", entry: `envs\prod\main.tf`, want: "envs/prod/main.tf"},
+		{name: "dot segments", entry: "./envs/./main.tf", want: "envs/main.tf"},
+		{name: "root entry", entry: "./", want: "."},
+		{name: "parent directory", entry: "../main.tf", wantErr: true},
+		{name: "nested parent directory", entry: "envs/../../main.tf", wantErr: true},
+		{name: "parent inside path", entry: "envs/../main.tf", wantErr: true},
+		{name: "windows parent directory", entry: `..\..\etc\passwd`, wantErr: true},
+		{name: "absolute path", entry: "/etc/passwd", wantErr: true},
+		{name: "windows absolute path", entry: `\etc\passwd`, wantErr: true},
+		{name: "empty name", entry: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sandbox.resolve(tt.entry)
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnsafePath) {
+					t.Fatalf("resolve(%q) error = %v, want ErrUnsafePath", tt.entry, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolve(%q) error = %v", tt.entry, err)
+			}
+
+			want := filepath.Join(sandbox.Dir, filepath.FromSlash(tt.want))
+			if got != want {
+				t.Errorf("resolve(%q) = %q, want %q", tt.entry, got, want)
+			}
+		})
+	}
+}
+
+func TestSandboxAddZipSlip(t *testing.T) {
+	root := t.TempDir()
+
+	tests := []struct {
+		name    string
+		entries []string
+		wantErr error
+	}{
+		{name: "safe entries", entries: []string{"main.tf", "envs/prod/main.tf"}},
+		{name: "parent directory", entries: []string{"main.tf", "../escape.tf"}, wantErr: ErrUnsafePath},
+		{name: "absolute path", entries: []string{"/tmp/escape.tf"}, wantErr: ErrUnsafePath},
+		{name: "duplicate entries", entries: []string{"main.tf", "./main.tf"}, wantErr: ErrDuplicatePath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer := zip.NewWriter(&buf)
+			for _, name := range tt.entries {
+				w, err := writer.Create(name)
+				if err != nil {
+					t.Fatalf("failed to create zip entry: %v", err)
+				}
+				w.Write([]byte("# " + name))
+			}
+			if err := writer.Close(); err != nil {
+				t.Fatalf("failed to write zip: %v", err)
+			}
+
+			sandbox, err := NewSandbox(root, Limits{MaxBytes: 1 << 20, MaxEntries: 10})
+			if err != nil {
+				t.Fatalf("NewSandbox() error = %v", err)
+			}
+			defer sandbox.Remove()
+
+			_, err = sandbox.Add("upload.zip", bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Add() error = %v", err)
+			}
+
+			// 작업 디렉토리 밖에는 아무것도 쓰지 않음
+			entries, err := os.ReadDir(root)
+			if err != nil {
+				t.Fatalf("failed to read root: %v", err)
+			}
+			if len(entries) != 1 || entries[0].Name() != filepath.Base(sandbox.Dir) {
+				t.Errorf("root entries = %v, want only the sandbox directory", entries)
+			}
+		})
+	}
+}
+
+func TestSandboxAddZipEntryLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		wantErr error
+	}{
+		{name: "within limit", entries: []string{"envs/", "envs/main.tf", "main.tf"}},
+		{name: "directories only", entries: []string{"a/", "b/", "c/", "d/"}, wantErr: ErrLimitExceeded},
+		{name: "directories and files", entries: []string{"envs/", "envs/prod/", "envs/prod/main.tf", "main.tf"}, wantErr: ErrLimitExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer := zip.NewWriter(&buf)
+			for _, name := range tt.entries {
+				w, err := writer.Create(name)
+				if err != nil {
+					t.Fatalf("failed to create zip entry: %v", err)
+				}
+				if !strings.HasSuffix(name, "/") {
+					w.Write([]byte("# " + name))
+				}
+			}
+			if err := writer.Close(); err != nil {
+				t.Fatalf("failed to write zip: %v", err)
+			}
+
+			sandbox, err := NewSandbox(t.TempDir(), Limits{MaxBytes: 1 << 20, MaxEntries: 3})
+			if err != nil {
+				t.Fatalf("NewSandbox() error = %v", err)
+			}
+			defer sandbox.Remove()
+
+			_, err = sandbox.Add("upload.zip", bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Add() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+{{- /* 실패 항목을 CSV로 출력합니다: format=template&template=@csv */ -}}
+target,id,severity,resource,start_line,end_line,message
+{{- range $result := .Results }}
+{{- range failures $result }}
+{{ escapeCSV $result.Target }},{{ escapeCSV .ID }},{{ .Severity }},{{ if .CauseMetadata }}{{ escapeCSV .CauseMetadata.Resource }},{{ .CauseMetadata.StartLine }},{{ .CauseMetadata.EndLine }}{{ else }},,{{ end }},{{ escapeCSV .Message }}
+{{- end }}
+{{- end }}
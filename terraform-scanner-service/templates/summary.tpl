@@ -0,0 +1,7 @@
+{{- /* 대상별 심각도 요약을 한 줄씩 출력합니다: format=template&template=@summary */ -}}
+{{- $total := severityCounts . -}}
+{{ .ArtifactName }}: {{ countFailures . }} failures ({{ range $i, $s := severities }}{{ if $i }}, {{ end }}{{ $s }}: {{ index $total $s }}{{ end }})
+{{- range .Results }}
+{{- $counts := severityCounts . }}
+  {{ .Target }}: {{ countFailures . }} failures ({{ range $i, $s := severities }}{{ if $i }}, {{ end }}{{ $s }}: {{ index $counts $s }}{{ end }})
+{{- end }}